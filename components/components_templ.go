@@ -10,6 +10,7 @@ import "io"
 import "bytes"
 
 import "strconv"
+import "net/url"
 import "github.com/magnuswahlstrand/htmx-experiments/types"
 
 func Color(trigger string, color string, animate bool) templ.Component {
@@ -252,7 +253,7 @@ func Description() templ.Component {
 	})
 }
 
-func Page(serverVersion string) templ.Component {
+func Layout(title string, serverVersion string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -269,8 +270,8 @@ func Page(serverVersion string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var_21 := `HTMX Examples`
-		_, err = templBuffer.WriteString(var_21)
+		var var_21 string = title
+		_, err = templBuffer.WriteString(templ.EscapeString(var_21))
 		if err != nil {
 			return err
 		}
@@ -292,7 +293,7 @@ func Page(serverVersion string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</script><script src=\"https://unpkg.com/htmx.org/dist/ext/debug.js\">")
+		_, err = templBuffer.WriteString("</script><script src=\"https://unpkg.com/htmx.org@1.9.6/dist/ext/debug.js\">")
 		if err != nil {
 			return err
 		}
@@ -301,7 +302,7 @@ func Page(serverVersion string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</script><script src=\"https://unpkg.com/htmx.org/dist/ext/sse.js\">")
+		_, err = templBuffer.WriteString("</script><script src=\"https://unpkg.com/htmx.org@1.9.6/dist/ext/sse.js\">")
 		if err != nil {
 			return err
 		}
@@ -310,24 +311,38 @@ func Page(serverVersion string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</script><link rel=\"stylesheet\" href=\"styles.css\"></head><h1 class=\"text-4xl font-bold mb-4\">")
+		_, err = templBuffer.WriteString("</script><script src=\"https://unpkg.com/htmx.org@1.9.6/dist/ext/response-targets.js\">")
 		if err != nil {
 			return err
 		}
-		var_26 := `Hello HTMX`
+		var_26 := ``
 		_, err = templBuffer.WriteString(var_26)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</h1><body class=\"bg-gray-100 p-4\" _=\"on keyup[key is &#39;Escape&#39;] if #modal exists trigger closeModal on #modal\">")
+		_, err = templBuffer.WriteString("</script><link id=\"styles\" rel=\"stylesheet\" href=\"/styles.css\"></head><body class=\"bg-gray-100 p-4\" hx-ext=\"response-targets\" hx-target-error=\"#toasts\" _=\"on keyup[key is &#39;Escape&#39;] if #modal exists trigger closeModal on #modal\"><div id=\"toasts\" class=\"fixed bottom-4 right-4 z-50 flex flex-col gap-2\"></div><div class=\"flex flex-row justify-between items-start\"><h1 class=\"text-4xl font-bold mb-4\"><a href=\"/\" hx-boost=\"true\">")
 		if err != nil {
 			return err
 		}
-		err = Description().Render(ctx, templBuffer)
+		var_27 := `Hello HTMX`
+		_, err = templBuffer.WriteString(var_27)
 		if err != nil {
 			return err
 		}
-		err = Examples().Render(ctx, templBuffer)
+		_, err = templBuffer.WriteString("</a></h1><button class=\"border-2 border-black rounded px-3 py-2\" hx-post=\"/session/reset\" hx-confirm=\"Reset the examples to their initial data? Your changes will be lost.\">")
+		if err != nil {
+			return err
+		}
+		var_28 := `Reset my demo`
+		_, err = templBuffer.WriteString(var_28)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></div>")
+		if err != nil {
+			return err
+		}
+		err = var_20.Render(ctx, templBuffer)
 		if err != nil {
 			return err
 		}
@@ -339,6 +354,10 @@ func Page(serverVersion string) templ.Component {
 		if err != nil {
 			return err
 		}
+		err = TableStyling().Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
 		_, err = templBuffer.WriteString("</body></html>")
 		if err != nil {
 			return err
@@ -350,6 +369,147 @@ func Page(serverVersion string) templ.Component {
 	})
 }
 
+func Page(serverVersion string, examples []ExampleCard) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_29 := templ.GetChildren(ctx)
+		if var_29 == nil {
+			var_29 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_30 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			err = Description().Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" ")
+			if err != nil {
+				return err
+			}
+			err = Examples(examples).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = Layout("HTMX Examples", serverVersion).Render(templ.WithChildren(ctx, var_30), templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func FragmentPage(serverVersion string, content templ.Component) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_31 := templ.GetChildren(ctx)
+		if var_31 == nil {
+			var_31 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_32 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<div class=\"bg-white p-4 rounded-lg shadow-md max-w-3xl\">")
+			if err != nil {
+				return err
+			}
+			err = content.Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = Layout("HTMX Examples", serverVersion).Render(templ.WithChildren(ctx, var_32), templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RowsPage(serverVersion string, rows templ.Component) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_33 := templ.GetChildren(ctx)
+		if var_33 == nil {
+			var_33 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_34 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<div class=\"bg-white p-4 rounded-lg shadow-md max-w-3xl\"><table class=\"w-full\"><tbody>")
+			if err != nil {
+				return err
+			}
+			err = rows.Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</tbody></table></div>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = Layout("HTMX Examples", serverVersion).Render(templ.WithChildren(ctx, var_34), templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
 func Spinner(suffix string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
@@ -358,9 +518,9 @@ func Spinner(suffix string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_27 := templ.GetChildren(ctx)
-		if var_27 == nil {
-			var_27 = templ.NopComponent
+		var_35 := templ.GetChildren(ctx)
+		if var_35 == nil {
+			var_35 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<img id=\"")
@@ -390,20 +550,20 @@ func SseReconnecter(serverVersion string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_28 := templ.GetChildren(ctx)
-		if var_28 == nil {
-			var_28 = templ.NopComponent
+		var_36 := templ.GetChildren(ctx)
+		if var_36 == nil {
+			var_36 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<div hx-ext=\"sse\" sse-connect=\"/sse\"><div hx-get=\"/reload\" hx-trigger=\"sse:TriggerReload\" hx-vals=\"")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(`{"timestamp": "` + serverVersion + `"}`))
+		_, err = templBuffer.WriteString(templ.EscapeString(`{"version": "` + serverVersion + `"}`))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("\"></div></div>")
+		_, err = templBuffer.WriteString("\"></div><div hx-get=\"/reload?force=true\" hx-trigger=\"sse:Refresh\"></div><div sse-swap=\"ReloadCSS\" hx-target=\"#styles\" hx-swap=\"outerHTML\"></div></div>")
 		if err != nil {
 			return err
 		}
@@ -414,7 +574,15 @@ func SseReconnecter(serverVersion string) templ.Component {
 	})
 }
 
-func ClickToLoadRows(rows []int, page int) templ.Component {
+func clickToLoadURL(cursor string, size int, infinite bool) string {
+	u := "/click_to_load?cursor=" + url.QueryEscape(cursor) + "&size=" + strconv.Itoa(size)
+	if infinite {
+		u += "&mode=scroll"
+	}
+	return u
+}
+
+func AgentRow(agent types.Agent) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -422,60 +590,129 @@ func ClickToLoadRows(rows []int, page int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_29 := templ.GetChildren(ctx)
-		if var_29 == nil {
-			var_29 = templ.NopComponent
+		var_37 := templ.GetChildren(ctx)
+		if var_37 == nil {
+			var_37 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<td class=\"text-center\">")
+		if err != nil {
+			return err
+		}
+		var var_38 string = strconv.Itoa(agent.ID)
+		_, err = templBuffer.WriteString(templ.EscapeString(var_38))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td class=\"text-center\">")
+		if err != nil {
+			return err
+		}
+		var var_39 string = agent.Name
+		_, err = templBuffer.WriteString(templ.EscapeString(var_39))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ClickToLoadRows renders a page of agents followed by what loads the next page: a button, or
+// in infinite mode the last row itself once it is revealed. next is empty on the last page.
+
+func ClickToLoadRows(agents []types.Agent, next string, size int, infinite bool) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_40 := templ.GetChildren(ctx)
+		if var_40 == nil {
+			var_40 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		for _, row := range rows {
-			_, err = templBuffer.WriteString("<tr><td class=\"text-center\">")
+		for i, agent := range agents {
+			if infinite && next != "" && i == len(agents)-1 {
+				_, err = templBuffer.WriteString("<tr hx-get=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString(clickToLoadURL(next, size, infinite)))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\" hx-trigger=\"revealed\" hx-swap=\"afterend\" hx-indicator=\"#spinner-scroll\">")
+				if err != nil {
+					return err
+				}
+				err = AgentRow(agent).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</tr>")
+				if err != nil {
+					return err
+				}
+			} else {
+				_, err = templBuffer.WriteString("<tr>")
+				if err != nil {
+					return err
+				}
+				err = AgentRow(agent).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</tr>")
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if next == "" {
+			_, err = templBuffer.WriteString("<tr><td colspan=\"2\" class=\"text-center italic text-stone-500 py-2\">")
 			if err != nil {
 				return err
 			}
-			var var_30 string = strconv.Itoa(row)
-			_, err = templBuffer.WriteString(templ.EscapeString(var_30))
+			var_41 := `End of list`
+			_, err = templBuffer.WriteString(var_41)
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("</td><td class=\"text-center\">")
+			_, err = templBuffer.WriteString("</td></tr>")
 			if err != nil {
 				return err
 			}
-			var_31 := `Agent Smith #`
-			_, err = templBuffer.WriteString(var_31)
+		} else if !infinite {
+			_, err = templBuffer.WriteString("<tr id=\"replaceMe\"><td colspan=\"2\"><button hx-target=\"#replaceMe\" hx-swap=\"outerHTML\" class=\"mt-3 mx-auto flex flex-row border-2 border-black rounded items-center px-3 py-2 gap-2 disabled:opacity-50 disabled:bg-stone-200 disabled:cursor-not-allowed\" hx-indicator=\"#spinner\" hx-disabled-elt=\"this\" hx-get=\"")
 			if err != nil {
 				return err
 			}
-			var var_32 string = strconv.Itoa(row)
-			_, err = templBuffer.WriteString(templ.EscapeString(var_32))
+			_, err = templBuffer.WriteString(templ.EscapeString(clickToLoadURL(next, size, infinite)))
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("</td></tr>")
+			_, err = templBuffer.WriteString("\">")
+			if err != nil {
+				return err
+			}
+			var_42 := `Load more agents`
+			_, err = templBuffer.WriteString(var_42)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" <img id=\"spinner\" class=\"htmx-indicator h-6 w-6 animate-spin\" src=\"/spinner.svg\"></button></td></tr>")
 			if err != nil {
 				return err
 			}
-		}
-		_, err = templBuffer.WriteString("<tr id=\"replaceMe\"><td colspan=\"2\"><button hx-target=\"#replaceMe\" hx-swap=\"outerHTML\" class=\"mt-3 mx-auto flex flex-row border-2 border-black rounded items-center px-3 py-2 gap-2 disabled:opacity-50 disabled:bg-stone-200 disabled:cursor-not-allowed\" hx-indicator=\"#spinner\" hx-disabled-elt=\"this\" hx-get=\"")
-		if err != nil {
-			return err
-		}
-		_, err = templBuffer.WriteString(templ.EscapeString("/click_to_load?page=" + strconv.Itoa(page)))
-		if err != nil {
-			return err
-		}
-		_, err = templBuffer.WriteString("\">")
-		if err != nil {
-			return err
-		}
-		var_33 := `Load more agents`
-		_, err = templBuffer.WriteString(var_33)
-		if err != nil {
-			return err
-		}
-		_, err = templBuffer.WriteString(" <img id=\"spinner\" class=\"htmx-indicator h-6 w-6 animate-spin\" src=\"/spinner.svg\"></button></td></tr>")
-		if err != nil {
-			return err
 		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
@@ -486,8 +723,6 @@ func ClickToLoadRows(rows []int, page int) templ.Component {
 
 const buttonClasses = "mt-3 mx-auto flex flex-row border-2 border-black rounded items-center px-3 py-2 gap-2 disabled:opacity-50 disabled:bg-stone-200 disabled:cursor-not-allowed "
 
-var initialRows = []int{1, 2}
-
 func Modal() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
@@ -496,17 +731,17 @@ func Modal() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_34 := templ.GetChildren(ctx)
-		if var_34 == nil {
-			var_34 = templ.NopComponent
+		var_43 := templ.GetChildren(ctx)
+		if var_43 == nil {
+			var_43 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<div id=\"modal\" _=\"on closeModal add .closing then wait for animationend then remove me\"><div class=\"modal-underlay\" _=\"on click trigger closeModal\"></div><div class=\"modal-content\"><h1 class=\"text-2xl font-semibold mb-2\">")
 		if err != nil {
 			return err
 		}
-		var_35 := `Modal Dialog`
-		_, err = templBuffer.WriteString(var_35)
+		var_44 := `Modal Dialog`
+		_, err = templBuffer.WriteString(var_44)
 		if err != nil {
 			return err
 		}
@@ -514,8 +749,8 @@ func Modal() templ.Component {
 		if err != nil {
 			return err
 		}
-		var_36 := `This is the modal content.`
-		_, err = templBuffer.WriteString(var_36)
+		var_45 := `This is the modal content.`
+		_, err = templBuffer.WriteString(var_45)
 		if err != nil {
 			return err
 		}
@@ -523,8 +758,8 @@ func Modal() templ.Component {
 		if err != nil {
 			return err
 		}
-		var_37 := `You can put anything here, like text, or a form, or an image. Press 'Escape' to close it.`
-		_, err = templBuffer.WriteString(var_37)
+		var_46 := `You can put anything here, like text, or a form, or an image. Press 'Escape' to close it.`
+		_, err = templBuffer.WriteString(var_46)
 		if err != nil {
 			return err
 		}
@@ -532,8 +767,8 @@ func Modal() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_38 = []any{buttonClasses}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_38...)
+		var var_47 = []any{buttonClasses}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_47...)
 		if err != nil {
 			return err
 		}
@@ -541,7 +776,7 @@ func Modal() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_38).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_47).String()))
 		if err != nil {
 			return err
 		}
@@ -549,8 +784,8 @@ func Modal() templ.Component {
 		if err != nil {
 			return err
 		}
-		var_39 := `Close`
-		_, err = templBuffer.WriteString(var_39)
+		var_48 := `Close`
+		_, err = templBuffer.WriteString(var_48)
 		if err != nil {
 			return err
 		}
@@ -565,7 +800,9 @@ func Modal() templ.Component {
 	})
 }
 
-func ContactShared(contact types.Contact, edit bool) templ.Component {
+const inputClasses = "shadow appearance-none border rounded w-full py-2 px-3 text-gray-700 leading-tight focus:outline-none focus:shadow-outline "
+
+func ContactField(contact types.Contact, field, label, value string, edit bool, validationError string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -573,52 +810,50 @@ func ContactShared(contact types.Contact, edit bool) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_40 := templ.GetChildren(ctx)
-		if var_40 == nil {
-			var_40 = templ.NopComponent
+		var_49 := templ.GetChildren(ctx)
+		if var_49 == nil {
+			var_49 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		_, err = templBuffer.WriteString("<div class=\"flex flex-col\"><label class=\"block text-gray-700 text-sm font-bold mb-2\">")
+		_, err = templBuffer.WriteString("<div class=\"flex flex-col\" id=\"")
 		if err != nil {
 			return err
 		}
-		var_41 := `Name`
-		_, err = templBuffer.WriteString(var_41)
+		_, err = templBuffer.WriteString(templ.EscapeString("contact-" + strconv.Itoa(contact.ID) + "-" + field))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</label><input type=\"text\" name=\"name\" value=\"")
+		_, err = templBuffer.WriteString("\"><label class=\"block text-gray-700 text-sm font-bold mb-2\">")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(contact.Name))
+		var var_50 string = label
+		_, err = templBuffer.WriteString(templ.EscapeString(var_50))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("\"")
+		_, err = templBuffer.WriteString("</label>")
 		if err != nil {
 			return err
 		}
-		if !edit {
-			_, err = templBuffer.WriteString(" disabled")
-			if err != nil {
-				return err
-			}
+		var var_51 = []any{templ.SafeClass(inputClasses + cls(validationError != "", "border-red-500"))}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_51...)
+		if err != nil {
+			return err
 		}
-		_, err = templBuffer.WriteString(" class=\"shadow appearance-none border rounded w-full py-2 px-3 text-gray-700 leading-tight focus:outline-none focus:shadow-outline\"></div><div class=\"flex flex-col\"><label class=\"block text-gray-700 text-sm font-bold mb-2\">")
+		_, err = templBuffer.WriteString("<input type=\"text\" name=\"")
 		if err != nil {
 			return err
 		}
-		var_42 := `Email Address`
-		_, err = templBuffer.WriteString(var_42)
+		_, err = templBuffer.WriteString(templ.EscapeString(field))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</label><input type=\"text\" name=\"email\" value=\"")
+		_, err = templBuffer.WriteString("\" value=\"")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(contact.Email))
+		_, err = templBuffer.WriteString(templ.EscapeString(value))
 		if err != nil {
 			return err
 		}
@@ -632,7 +867,48 @@ func ContactShared(contact types.Contact, edit bool) templ.Component {
 				return err
 			}
 		}
-		_, err = templBuffer.WriteString(" class=\"shadow appearance-none border rounded w-full py-2 px-3 text-gray-700 leading-tight focus:outline-none focus:shadow-outline\"></div>")
+		if edit {
+			_, err = templBuffer.WriteString(" hx-post=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(contactURL(contact, "/validate/"+field)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" hx-trigger=\"change\" hx-target=\"closest div\" hx-swap=\"outerHTML\"")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString(" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_51).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		if validationError != "" {
+			_, err = templBuffer.WriteString("<p class=\"text-red-500 text-xs italic mt-1\">")
+			if err != nil {
+				return err
+			}
+			var var_52 string = validationError
+			_, err = templBuffer.WriteString(templ.EscapeString(var_52))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</p>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</div>")
 		if err != nil {
 			return err
 		}
@@ -643,7 +919,7 @@ func ContactShared(contact types.Contact, edit bool) templ.Component {
 	})
 }
 
-func ContactForm(contact types.Contact, edit bool) templ.Component {
+func ContactShared(contact types.Contact, edit bool, errs types.ContactErrors) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -651,66 +927,729 @@ func ContactForm(contact types.Contact, edit bool) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_43 := templ.GetChildren(ctx)
-		if var_43 == nil {
-			var_43 = templ.NopComponent
+		var_53 := templ.GetChildren(ctx)
+		if var_53 == nil {
+			var_53 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		err = ContactField(contact, "name", "Name", contact.Name, edit, errs.Name).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		err = ContactField(contact, "email", "Email Address", contact.Email, edit, errs.Email).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func contactURL(contact types.Contact, suffix string) string {
+	return "/contacts/" + strconv.Itoa(contact.ID) + suffix
+}
+
+func ContactForm(contact types.Contact, edit bool, errs types.ContactErrors) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_54 := templ.GetChildren(ctx)
+		if var_54 == nil {
+			var_54 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		if edit {
-			_, err = templBuffer.WriteString("<form hx-put=\"/contacts/1\" hx-target=\"this\" hx-swap=\"outerHTML\" class=\"\">")
+			_, err = templBuffer.WriteString("<form id=\"")
 			if err != nil {
 				return err
 			}
-			err = ContactShared(contact, edit).Render(ctx, templBuffer)
+			_, err = templBuffer.WriteString(templ.EscapeString("contact-" + strconv.Itoa(contact.ID)))
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("<div class=\"flex flex-row mt-4 gap-2\"><button class=\"bg-blue-500 rounded-lg border-2 border-black p-2\">")
+			_, err = templBuffer.WriteString("\" hx-put=\"")
 			if err != nil {
 				return err
 			}
-			var_44 := `Submit`
-			_, err = templBuffer.WriteString(var_44)
+			_, err = templBuffer.WriteString(templ.EscapeString(contactURL(contact, "")))
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("</button><button class=\"bg-blue-500 rounded-lg border-2 border-black p-2\" hx-get=\"/contacts/1\">")
+			_, err = templBuffer.WriteString("\" hx-target=\"this\" hx-target-422=\"this\" hx-target-409=\"this\" hx-swap=\"outerHTML\" class=\"\"><input type=\"hidden\" name=\"version\" value=\"")
 			if err != nil {
 				return err
 			}
-			var_45 := `Cancel`
-			_, err = templBuffer.WriteString(var_45)
+			_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(contact.Version)))
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("</button></div></form>")
+			_, err = templBuffer.WriteString("\">")
 			if err != nil {
 				return err
 			}
-		} else {
-			_, err = templBuffer.WriteString("<div hx-target=\"this\" hx-swap=\"outerHTML\">")
+			err = ContactShared(contact, edit, errs).Render(ctx, templBuffer)
 			if err != nil {
 				return err
 			}
-			err = ContactShared(contact, edit).Render(ctx, templBuffer)
+			_, err = templBuffer.WriteString("<div class=\"flex flex-row mt-4 gap-2\"><button class=\"bg-blue-500 rounded-lg border-2 border-black p-2\">")
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("<button hx-get=\"/contacts/1/edit\" class=\"bg-blue-500 rounded-lg border-2 border-black p-2 mt-4\">")
+			var_55 := `Submit`
+			_, err = templBuffer.WriteString(var_55)
 			if err != nil {
 				return err
 			}
-			var_46 := `Click To Edit`
-			_, err = templBuffer.WriteString(var_46)
+			_, err = templBuffer.WriteString("</button><button class=\"bg-blue-500 rounded-lg border-2 border-black p-2\" hx-get=\"")
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString("</button></div>")
+			_, err = templBuffer.WriteString(templ.EscapeString(contactURL(contact, "")))
 			if err != nil {
 				return err
 			}
-		}
-		if !templIsBuffer {
+			_, err = templBuffer.WriteString("\">")
+			if err != nil {
+				return err
+			}
+			var_56 := `Cancel`
+			_, err = templBuffer.WriteString(var_56)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</button></div></form>")
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err = templBuffer.WriteString("<div id=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString("contact-" + strconv.Itoa(contact.ID)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" hx-target=\"this\" hx-swap=\"outerHTML\">")
+			if err != nil {
+				return err
+			}
+			err = ContactShared(contact, edit, errs).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("<button hx-get=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(contactURL(contact, "/edit")))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" class=\"bg-blue-500 rounded-lg border-2 border-black p-2 mt-4\">")
+			if err != nil {
+				return err
+			}
+			var_57 := `Click To Edit`
+			_, err = templBuffer.WriteString(var_57)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</button></div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ClickToEdit shows the first contact with its history, or an empty state once every contact
+// has been deleted.
+
+func ClickToEdit(contact types.Contact, found bool) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_58 := templ.GetChildren(ctx)
+		if var_58 == nil {
+			var_58 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if found {
+			err = ContactForm(contact, false, types.ContactErrors{}).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" <div hx-get=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(contactURL(contact, "/history")))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" hx-trigger=\"load, contactChanged from:body\"></div>")
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err = templBuffer.WriteString("<div class=\"italic text-stone-500\">")
+			if err != nil {
+				return err
+			}
+			var_59 := `All contacts have been deleted. Reset your demo to get them back.`
+			_, err = templBuffer.WriteString(var_59)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ConflictChoice(field, label, yours, current string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_60 := templ.GetChildren(ctx)
+		if var_60 == nil {
+			var_60 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<fieldset class=\"flex flex-col mb-2\"><legend class=\"block text-gray-700 text-sm font-bold mb-1\">")
+		if err != nil {
+			return err
+		}
+		var var_61 string = label
+		_, err = templBuffer.WriteString(templ.EscapeString(var_61))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</legend><label><input type=\"radio\" name=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(field))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(yours))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" checked> ")
+		if err != nil {
+			return err
+		}
+		var_62 := `Your change: `
+		_, err = templBuffer.WriteString(var_62)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<span class=\"font-semibold\">")
+		if err != nil {
+			return err
+		}
+		var var_63 string = yours
+		_, err = templBuffer.WriteString(templ.EscapeString(var_63))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span></label><label><input type=\"radio\" name=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(field))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(current))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"> ")
+		if err != nil {
+			return err
+		}
+		var_64 := `Current value: `
+		_, err = templBuffer.WriteString(var_64)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<span class=\"font-semibold\">")
+		if err != nil {
+			return err
+		}
+		var var_65 string = current
+		_, err = templBuffer.WriteString(templ.EscapeString(var_65))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span></label></fieldset>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ContactConflict is shown when the contact was changed by someone else while it was being
+// edited. Submitting it saves the chosen values on top of the current version.
+
+func ContactConflict(yours types.Contact, current types.Contact) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_66 := templ.GetChildren(ctx)
+		if var_66 == nil {
+			var_66 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<form id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("contact-" + strconv.Itoa(current.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-put=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactURL(current, "")))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-target=\"this\" hx-target-422=\"this\" hx-target-409=\"this\" hx-swap=\"outerHTML\"><div class=\"bg-yellow-100 border-2 border-yellow-500 rounded p-2 mb-2\">")
+		if err != nil {
+			return err
+		}
+		var_67 := `This contact was changed while you were editing it. Choose which values to keep.`
+		_, err = templBuffer.WriteString(var_67)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div><input type=\"hidden\" name=\"version\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(current.Version)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		err = ConflictChoice("name", "Name", yours.Name, current.Name).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		err = ConflictChoice("email", "Email Address", yours.Email, current.Email).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<div class=\"flex flex-row mt-4 gap-2\"><button class=\"bg-blue-500 rounded-lg border-2 border-black p-2\">")
+		if err != nil {
+			return err
+		}
+		var_68 := `Save`
+		_, err = templBuffer.WriteString(var_68)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button><button class=\"bg-blue-500 rounded-lg border-2 border-black p-2\" hx-get=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactURL(current, "")))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_69 := `Discard my change`
+		_, err = templBuffer.WriteString(var_69)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></div></form>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ContactChangeDiff(label, old, new string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_70 := templ.GetChildren(ctx)
+		if var_70 == nil {
+			var_70 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if old != new {
+			_, err = templBuffer.WriteString("<div>")
+			if err != nil {
+				return err
+			}
+			var var_71 string = label
+			_, err = templBuffer.WriteString(templ.EscapeString(var_71))
+			if err != nil {
+				return err
+			}
+			var_72 := `: `
+			_, err = templBuffer.WriteString(var_72)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("<span class=\"line-through\">")
+			if err != nil {
+				return err
+			}
+			var var_73 string = old
+			_, err = templBuffer.WriteString(templ.EscapeString(var_73))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span> ")
+			if err != nil {
+				return err
+			}
+			var_74 := `→ `
+			_, err = templBuffer.WriteString(var_74)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("<span class=\"font-semibold\">")
+			if err != nil {
+				return err
+			}
+			var var_75 string = new
+			_, err = templBuffer.WriteString(templ.EscapeString(var_75))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span></div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func UndoButton(change types.ContactChange) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_76 := templ.GetChildren(ctx)
+		if var_76 == nil {
+			var_76 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<button class=\"underline\" hx-post=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactURL(change.New, "/undo/"+strconv.Itoa(change.ID))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-target=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("#contact-" + strconv.Itoa(change.New.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-swap=\"outerHTML\">")
+		if err != nil {
+			return err
+		}
+		var_77 := `Undo`
+		_, err = templBuffer.WriteString(var_77)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ContactHistory lists the changes of a contact, newest first. Only the newest change can be
+// undone, and only while it is the current version.
+
+func ContactHistory(changes []types.ContactChange, current types.Contact) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_78 := templ.GetChildren(ctx)
+		if var_78 == nil {
+			var_78 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("contact-history-" + strconv.Itoa(current.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" class=\"mt-4 text-sm\"><h3 class=\"font-bold mb-1\">")
+		if err != nil {
+			return err
+		}
+		var_79 := `History`
+		_, err = templBuffer.WriteString(var_79)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</h3>")
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			_, err = templBuffer.WriteString("<div class=\"italic text-stone-500\">")
+			if err != nil {
+				return err
+			}
+			var_80 := `No changes yet`
+			_, err = templBuffer.WriteString(var_80)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("<ul class=\"flex flex-col gap-1\">")
+		if err != nil {
+			return err
+		}
+		for i, change := range changes {
+			_, err = templBuffer.WriteString("<li class=\"border-l-2 border-stone-300 pl-2\"><div class=\"text-stone-500\">")
+			if err != nil {
+				return err
+			}
+			var var_81 string = change.Time.Format("15:04:05")
+			_, err = templBuffer.WriteString(templ.EscapeString(var_81))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" ")
+			if err != nil {
+				return err
+			}
+			var_82 := `by session `
+			_, err = templBuffer.WriteString(var_82)
+			if err != nil {
+				return err
+			}
+			var var_83 string = change.Session[:min(len(change.Session), 6)]
+			_, err = templBuffer.WriteString(templ.EscapeString(var_83))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+			if change.Deleted {
+				_, err = templBuffer.WriteString("<div>")
+				if err != nil {
+					return err
+				}
+				var_84 := `Deleted`
+				_, err = templBuffer.WriteString(var_84)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</div>")
+				if err != nil {
+					return err
+				}
+			} else {
+				err = ContactChangeDiff("Name", change.Old.Name, change.New.Name).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(" ")
+				if err != nil {
+					return err
+				}
+				err = ContactChangeDiff("Email", change.Old.Email, change.New.Email).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(" ")
+				if err != nil {
+					return err
+				}
+				err = ContactChangeDiff("Status", contactStatusText(change.Old.Active), contactStatusText(change.New.Active)).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+			}
+			if i == 0 && change.New.Version == current.Version {
+				err = UndoButton(change).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</ul></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// SavedToast is swapped out of band into the toast area after a contact is saved.
+
+func SavedToast(change types.ContactChange) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_85 := templ.GetChildren(ctx)
+		if var_85 == nil {
+			var_85 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div hx-swap-oob=\"beforeend:#toasts\"><div class=\"toast bg-green-100 border-2 border-green-500 rounded p-3 shadow-md text-green-700 w-72 flex flex-row justify-between\" _=\"on load wait 8s then transition opacity to 0 then remove me end on htmx:afterRequest remove me\">")
+		if err != nil {
+			return err
+		}
+		var_86 := `Contact saved.`
+		_, err = templBuffer.WriteString(var_86)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(" ")
+		if err != nil {
+			return err
+		}
+		err = UndoButton(change).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ContactSaved(contact types.Contact, change types.ContactChange) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_87 := templ.GetChildren(ctx)
+		if var_87 == nil {
+			var_87 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		err = ContactForm(contact, false, types.ContactErrors{}).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		err = SavedToast(change).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
 		return err
@@ -725,17 +1664,17 @@ func Tooltip(content string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_47 := templ.GetChildren(ctx)
-		if var_47 == nil {
-			var_47 = templ.NopComponent
+		var_88 := templ.GetChildren(ctx)
+		if var_88 == nil {
+			var_88 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<span class=\"cursor-pointer relative group\"><svg xmlns=\"http://www.w3.org/2000/svg\" width=\"18\" height=\"18\" viewBox=\"0 0 24 24\" fill=\"none\" stroke=\"currentColor\" stroke-width=\"2\" stroke-linecap=\"round\" stroke-linejoin=\"round\" class=\"lucide lucide-info\"><circle cx=\"12\" cy=\"12\" r=\"10\"></circle><path d=\"M12 16v-4\"></path><path d=\"M12 8h.01\"></path></svg><span class=\"absolute bottom-full left-0 w-64 bg-black text-white text-md p-2 rounded hidden group-hover:block transition duration-300\">")
 		if err != nil {
 			return err
 		}
-		var var_48 string = content
-		_, err = templBuffer.WriteString(templ.EscapeString(var_48))
+		var var_89 string = content
+		_, err = templBuffer.WriteString(templ.EscapeString(var_89))
 		if err != nil {
 			return err
 		}
@@ -749,3 +1688,154 @@ func Tooltip(content string) templ.Component {
 		return err
 	})
 }
+
+func RenderError() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_90 := templ.GetChildren(ctx)
+		if var_90 == nil {
+			var_90 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div class=\"bg-red-100 border-2 border-red-500 rounded p-2 text-red-700\">")
+		if err != nil {
+			return err
+		}
+		var_91 := `Something went wrong while rendering this part of the page.`
+		_, err = templBuffer.WriteString(var_91)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ErrorToast(code int, message string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_92 := templ.GetChildren(ctx)
+		if var_92 == nil {
+			var_92 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div class=\"bg-red-100 border-2 border-red-500 rounded p-3 shadow-md text-red-700 w-72\" _=\"on load wait 5s then transition opacity to 0 then remove me\"><div class=\"font-bold\">")
+		if err != nil {
+			return err
+		}
+		var_93 := `Error `
+		_, err = templBuffer.WriteString(var_93)
+		if err != nil {
+			return err
+		}
+		var var_94 string = strconv.Itoa(code)
+		_, err = templBuffer.WriteString(templ.EscapeString(var_94))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div><div>")
+		if err != nil {
+			return err
+		}
+		var var_95 string = message
+		_, err = templBuffer.WriteString(templ.EscapeString(var_95))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ErrorPage(serverVersion string, code int, message string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_96 := templ.GetChildren(ctx)
+		if var_96 == nil {
+			var_96 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_97 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<div class=\"bg-white p-4 rounded-lg shadow-md max-w-3xl\"><h2 class=\"text-2xl font-semibold mb-2\">")
+			if err != nil {
+				return err
+			}
+			var_98 := `Error `
+			_, err = templBuffer.WriteString(var_98)
+			if err != nil {
+				return err
+			}
+			var var_99 string = strconv.Itoa(code)
+			_, err = templBuffer.WriteString(templ.EscapeString(var_99))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</h2><div class=\"mb-4\">")
+			if err != nil {
+				return err
+			}
+			var var_100 string = message
+			_, err = templBuffer.WriteString(templ.EscapeString(var_100))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div><a href=\"/\" class=\"underline\">")
+			if err != nil {
+				return err
+			}
+			var_101 := `Back to the examples`
+			_, err = templBuffer.WriteString(var_101)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</a></div>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = Layout("Error "+strconv.Itoa(code)+" | HTMX Examples", serverVersion).Render(templ.WithChildren(ctx, var_97), templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -0,0 +1,692 @@
+// Code generated by templ@v0.2.364 DO NOT EDIT.
+
+package components
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+import "strconv"
+import "github.com/magnuswahlstrand/htmx-experiments/types"
+
+func contactRowID(id int) string {
+	return "contact-row-" + strconv.Itoa(id)
+}
+
+func contactStatusText(active bool) string {
+	if active {
+		return "Active"
+	}
+	return "Inactive"
+}
+
+func ContactStatus(active bool) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var var_2 = []any{"rounded px-2", templ.KV("bg-green-200", active), templ.KV("bg-stone-200", !active)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<span class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_2).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var var_3 string = contactStatusText(active)
+		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func contactRowURL(id int, path string) string {
+	return "/contacts/" + strconv.Itoa(id) + "/row" + path
+}
+
+// ContactRow renders a contact in the contacts table. Rows returned by a bulk action are swapped
+// out of band and marked as changed, which highlights them while they settle.
+
+func ContactRow(contact types.Contact, oob bool, changed bool) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_4 := templ.GetChildren(ctx)
+		if var_4 == nil {
+			var_4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<tr id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactRowID(contact.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"")
+		if err != nil {
+			return err
+		}
+		if changed {
+			_, err = templBuffer.WriteString(" class=\"changed\"")
+			if err != nil {
+				return err
+			}
+		}
+		if oob {
+			_, err = templBuffer.WriteString(" hx-swap-oob=\"true\"")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("><td class=\"text-center\"><input type=\"checkbox\" name=\"ids\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(contact.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"")
+		if err != nil {
+			return err
+		}
+		if changed {
+			_, err = templBuffer.WriteString(" checked")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("></td><td>")
+		if err != nil {
+			return err
+		}
+		var var_5 string = contact.Name
+		_, err = templBuffer.WriteString(templ.EscapeString(var_5))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td>")
+		if err != nil {
+			return err
+		}
+		var var_6 string = contact.Email
+		_, err = templBuffer.WriteString(templ.EscapeString(var_6))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td class=\"text-center\">")
+		if err != nil {
+			return err
+		}
+		err = ContactStatus(contact.Active).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td class=\"text-center\"><button type=\"button\" class=\"underline\" hx-get=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactRowURL(contact.ID, "/edit")))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-include=\"#contacts-table [name=&#39;editing&#39;]\" hx-target=\"closest tr\" hx-swap=\"outerHTML\">")
+		if err != nil {
+			return err
+		}
+		var_7 := `Edit`
+		_, err = templBuffer.WriteString(var_7)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button><button type=\"button\" class=\"underline\" hx-delete=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("/contacts/" + strconv.Itoa(contact.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-confirm=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("Delete " + contact.Name + "?"))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-target=\"closest tr\" hx-swap=\"outerHTML swap:1s\">")
+		if err != nil {
+			return err
+		}
+		var_8 := `Delete`
+		_, err = templBuffer.WriteString(var_8)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></td></tr>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ContactRowInput(name, value, validationError string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_9 := templ.GetChildren(ctx)
+		if var_9 == nil {
+			var_9 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var var_10 = []any{templ.SafeClass(inputClasses + cls(validationError != "", "border-red-500"))}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_10...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<input type=\"text\" name=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(name))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(value))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_10).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		if validationError != "" {
+			_, err = templBuffer.WriteString("<div class=\"text-red-500 text-xs italic\">")
+			if err != nil {
+				return err
+			}
+			var var_11 string = validationError
+			_, err = templBuffer.WriteString(templ.EscapeString(var_11))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ContactEditRow renders a row of the contacts table in edit mode. The hidden "editing" input
+// tells the server which row to cancel when another row is edited, and the rows in cancelled
+// are swapped back to read-only out of band.
+
+func ContactEditRow(contact types.Contact, errs types.ContactErrors, cancelled []types.Contact) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_12 := templ.GetChildren(ctx)
+		if var_12 == nil {
+			var_12 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<tr id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactRowID(contact.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><td class=\"text-center\"><input type=\"hidden\" name=\"editing\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(contact.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><input type=\"hidden\" name=\"version\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(contact.Version)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><input type=\"checkbox\" name=\"ids\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(contact.ID)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></td><td>")
+		if err != nil {
+			return err
+		}
+		err = ContactRowInput("name", contact.Name, errs.Name).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td>")
+		if err != nil {
+			return err
+		}
+		err = ContactRowInput("email", contact.Email, errs.Email).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td class=\"text-center\">")
+		if err != nil {
+			return err
+		}
+		err = ContactStatus(contact.Active).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</td><td class=\"text-center\"><button type=\"button\" class=\"underline\" hx-put=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactRowURL(contact.ID, "")))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-target=\"closest tr\" hx-target-422=\"closest tr\" hx-swap=\"outerHTML\">")
+		if err != nil {
+			return err
+		}
+		var_13 := `Save`
+		_, err = templBuffer.WriteString(var_13)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button><button type=\"button\" class=\"underline\" hx-get=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(contactRowURL(contact.ID, "")))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-target=\"closest tr\" hx-swap=\"outerHTML\">")
+		if err != nil {
+			return err
+		}
+		var_14 := `Cancel`
+		_, err = templBuffer.WriteString(var_14)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></td></tr>")
+		if err != nil {
+			return err
+		}
+		for _, contact := range cancelled {
+			err = ContactRow(contact, true, false).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ContactRowsChanged(contacts []types.Contact) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_15 := templ.GetChildren(ctx)
+		if var_15 == nil {
+			var_15 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		for _, contact := range contacts {
+			err = ContactRow(contact, true, true).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ContactRowsDeleted(ids []int) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_16 := templ.GetChildren(ctx)
+		if var_16 == nil {
+			var_16 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		for _, id := range ids {
+			_, err = templBuffer.WriteString("<tr id=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(contactRowID(id)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" hx-swap-oob=\"delete\"></tr>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ContactsTable lists the contacts with a checkbox on every row. The bulk actions send the
+// checked ids in a single request, and only swap the rows that the response contains. Submitting
+// the form, by pressing enter in a row being edited, is ignored.
+
+func ContactsTable(contacts []types.Contact) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_17 := templ.GetChildren(ctx)
+		if var_17 == nil {
+			var_17 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<form id=\"contacts-table\" hx-swap=\"none\" _=\"on submit halt the event\"><table class=\"w-full\"><thead><tr><th><input type=\"checkbox\" aria-label=\"Select all\" _=\"on change for box in &lt;input[name=&#39;ids&#39;]/&gt; in closest &lt;form/&gt; set box.checked to my.checked end\"></th><th class=\"text-left\">")
+		if err != nil {
+			return err
+		}
+		var_18 := `Name`
+		_, err = templBuffer.WriteString(var_18)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</th><th class=\"text-left\">")
+		if err != nil {
+			return err
+		}
+		var_19 := `Email`
+		_, err = templBuffer.WriteString(var_19)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</th><th>")
+		if err != nil {
+			return err
+		}
+		var_20 := `Status`
+		_, err = templBuffer.WriteString(var_20)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</th><th></th></tr></thead><tbody>")
+		if err != nil {
+			return err
+		}
+		if len(contacts) == 0 {
+			_, err = templBuffer.WriteString("<tr><td colspan=\"5\" class=\"text-center italic text-stone-500 py-2\">")
+			if err != nil {
+				return err
+			}
+			var_21 := `No contacts`
+			_, err = templBuffer.WriteString(var_21)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</td></tr>")
+			if err != nil {
+				return err
+			}
+		}
+		for _, contact := range contacts {
+			err = ContactRow(contact, false, false).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</tbody></table><div class=\"flex flex-row justify-center gap-2\">")
+		if err != nil {
+			return err
+		}
+		var var_22 = []any{templ.SafeClass(buttonClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_22...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<button type=\"button\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_22).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" name=\"status\" value=\"active\" hx-put=\"/contacts/bulk\">")
+		if err != nil {
+			return err
+		}
+		var_23 := `Activate`
+		_, err = templBuffer.WriteString(var_23)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button>")
+		if err != nil {
+			return err
+		}
+		var var_24 = []any{templ.SafeClass(buttonClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_24...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<button type=\"button\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_24).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" name=\"status\" value=\"inactive\" hx-put=\"/contacts/bulk\">")
+		if err != nil {
+			return err
+		}
+		var_25 := `Deactivate`
+		_, err = templBuffer.WriteString(var_25)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button>")
+		if err != nil {
+			return err
+		}
+		var var_26 = []any{templ.SafeClass(buttonClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_26...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<button type=\"button\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_26).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-delete=\"/contacts/bulk\" hx-confirm=\"Delete the selected contacts?\">")
+		if err != nil {
+			return err
+		}
+		var_27 := `Delete`
+		_, err = templBuffer.WriteString(var_27)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></div></form>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ExampleContactsTable() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_28 := templ.GetChildren(ctx)
+		if var_28 == nil {
+			var_28 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div hx-get=\"/contacts\" hx-trigger=\"load\" hx-swap=\"outerHTML\"></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// TableStyling highlights changed table rows while htmx settles them, and fades out rows while
+// htmx waits to swap them.
+
+func TableStyling() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_29 := templ.GetChildren(ctx)
+		if var_29 == nil {
+			var_29 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<style>")
+		if err != nil {
+			return err
+		}
+		var_30 := `
+		tr.htmx-swapping td {
+			opacity: 0;
+			transition: opacity 1s ease-out;
+		}
+		tr.changed td {
+			transition: background-color 1s ease-out;
+		}
+		tr.changed.htmx-added td {
+			background-color: #bbf7d0;
+		}
+	`
+		_, err = templBuffer.WriteString(var_30)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</style>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
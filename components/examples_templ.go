@@ -11,7 +11,16 @@ import "bytes"
 
 import "strconv"
 
-func Examples() templ.Component {
+// ExampleCard describes an example as it is shown on the index page.
+type ExampleCard struct {
+	Slug        string
+	Title       string
+	Description string
+	Tags        []string
+	Component   templ.Component
+}
+
+func Examples(examples []ExampleCard) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -24,188 +33,151 @@ func Examples() templ.Component {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		_, err = templBuffer.WriteString("<div class=\"flex flex-row flex-wrap gap-4 mt-8\">")
-		if err != nil {
-			return err
-		}
-		var_2 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			err = Color("mouseenter", "bg-red-500", true).Render(ctx, templBuffer)
+		_, err = templBuffer.WriteString("<div class=\"flex flex-row flex-wrap gap-4 mt-8\" hx-boost=\"true\">")
+		if err != nil {
+			return err
+		}
+		for _, example := range examples {
+			var_2 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+				templBuffer, templIsBuffer := w.(*bytes.Buffer)
+				if !templIsBuffer {
+					templBuffer = templ.GetBuffer()
+					defer templ.ReleaseBuffer(templBuffer)
+				}
+				err = example.Component.Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				if !templIsBuffer {
+					_, err = io.Copy(w, templBuffer)
+				}
+				return err
+			})
+			err = Example(example.Title, example.Slug, example.Description, example.Tags).Render(templ.WithChildren(ctx, var_2), templBuffer)
 			if err != nil {
 				return err
 			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
-			return err
-		})
-		err = Example("mouseover", "The box will fetch a new color from the server when you hover it").Render(templ.WithChildren(ctx, var_2), templBuffer)
+		}
+		_, err = templBuffer.WriteString("</div>")
 		if err != nil {
 			return err
 		}
-		var_3 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			err = ExampleGetOnLoad("load", "").Render(ctx, templBuffer)
-			if err != nil {
-				return err
-			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ExampleOpenModal() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_3 := templ.GetChildren(ctx)
+		if var_3 == nil {
+			var_3 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var var_4 = []any{buttonClasses}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_4...)
+		if err != nil {
 			return err
-		})
-		err = Example("get on load", "Fetches a new message from the server when the page loads").Render(templ.WithChildren(ctx, var_3), templBuffer)
+		}
+		_, err = templBuffer.WriteString("<button class=\"")
 		if err != nil {
 			return err
 		}
-		var_4 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			err = ExampleIndicator().Render(ctx, templBuffer)
-			if err != nil {
-				return err
-			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_4).String()))
+		if err != nil {
 			return err
-		})
-		err = Example("hx-indicator", "Uses the 'hx-indicator' attribute to show a loading indicator and the 'hx-disabled-elt' attribute to disable the button while the request is in flight").Render(templ.WithChildren(ctx, var_4), templBuffer)
+		}
+		_, err = templBuffer.WriteString("\" hx-target=\"body\" hx-get=\"/modal\" hx-swap=\"beforeend\">")
 		if err != nil {
 			return err
 		}
-		var_5 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			err = ExampleClickToLoadTable().Render(ctx, templBuffer)
-			if err != nil {
-				return err
-			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
+		var_5 := `Open Modal`
+		_, err = templBuffer.WriteString(var_5)
+		if err != nil {
 			return err
-		})
-		err = Example("click to load", "Click the button to load more rows from the server").Render(templ.WithChildren(ctx, var_5), templBuffer)
+		}
+		_, err = templBuffer.WriteString("</button>")
 		if err != nil {
 			return err
 		}
-		var_6 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			var var_7 = []any{buttonClasses}
-			err = templ.RenderCSSItems(ctx, templBuffer, var_7...)
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("<button class=\"")
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_7).String()))
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("\" hx-target=\"body\" hx-get=\"/modal\" hx-swap=\"beforeend\">")
-			if err != nil {
-				return err
-			}
-			var_8 := `Open Modal`
-			_, err = templBuffer.WriteString(var_8)
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("</button>")
-			if err != nil {
-				return err
-			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// ExampleClickToEdit refreshes the contact when it is changed or deleted elsewhere on the page,
+// such as in the contacts table, unless it is being edited.
+
+func ExampleClickToEdit() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_6 := templ.GetChildren(ctx)
+		if var_6 == nil {
+			var_6 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div hx-get=\"/click_to_edit\" hx-trigger=\"load, contactChanged[!this.querySelector(&#39;form&#39;)] from:body, contactDeleted[!this.querySelector(&#39;form&#39;)] from:body\"></div>")
+		if err != nil {
 			return err
-		})
-		err = Example("open modal", "Will open a modal when you click the button").Render(templ.WithChildren(ctx, var_6), templBuffer)
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ExampleShowProgress() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_7 := templ.GetChildren(ctx)
+		if var_7 == nil {
+			var_7 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var var_8 = []any{buttonClasses}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_8...)
 		if err != nil {
 			return err
 		}
-		var_9 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			_, err = templBuffer.WriteString("<div hx-get=\"/contacts/1\" hx-trigger=\"load\"></div>")
-			if err != nil {
-				return err
-			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
+		_, err = templBuffer.WriteString("<button class=\"")
+		if err != nil {
 			return err
-		})
-		err = Example("click to edit", "Sends form to the backend directly when click the Submit button and returns the server state").Render(templ.WithChildren(ctx, var_9), templBuffer)
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_8).String()))
 		if err != nil {
 			return err
 		}
-		var_10 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
-			templBuffer, templIsBuffer := w.(*bytes.Buffer)
-			if !templIsBuffer {
-				templBuffer = templ.GetBuffer()
-				defer templ.ReleaseBuffer(templBuffer)
-			}
-			var var_11 = []any{buttonClasses}
-			err = templ.RenderCSSItems(ctx, templBuffer, var_11...)
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("<button class=\"")
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_11).String()))
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("\" hx-get=\"/track\" hx-swap=\"outerHTML\">")
-			if err != nil {
-				return err
-			}
-			var_12 := `Track order`
-			_, err = templBuffer.WriteString(var_12)
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("</button>")
-			if err != nil {
-				return err
-			}
-			if !templIsBuffer {
-				_, err = io.Copy(w, templBuffer)
-			}
+		_, err = templBuffer.WriteString("\" hx-get=\"/track\" hx-swap=\"outerHTML\">")
+		if err != nil {
 			return err
-		})
-		err = Example("show progress", "Tracks a specific order until completion after it has been placed. Stops at completion.").Render(templ.WithChildren(ctx, var_10), templBuffer)
+		}
+		var_9 := `Track order`
+		_, err = templBuffer.WriteString(var_9)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</div>")
+		_, err = templBuffer.WriteString("</button>")
 		if err != nil {
 			return err
 		}
@@ -238,17 +210,17 @@ func TrackStep(isActive bool, label string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_13 := templ.GetChildren(ctx)
-		if var_13 == nil {
-			var_13 = templ.NopComponent
+		var_10 := templ.GetChildren(ctx)
+		if var_10 == nil {
+			var_10 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<div class=\"flex flex-row gap-3 z-10\">")
 		if err != nil {
 			return err
 		}
-		var var_14 = []any{"rounded-full h-8 w-8 flex items-center justify-center " + ifc(isActive, "bg-lime-400", "bg-stone-200")}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_14...)
+		var var_11 = []any{"rounded-full h-8 w-8 flex items-center justify-center " + ifc(isActive, "bg-lime-400", "bg-stone-200")}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_11...)
 		if err != nil {
 			return err
 		}
@@ -256,7 +228,7 @@ func TrackStep(isActive bool, label string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_14).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_11).String()))
 		if err != nil {
 			return err
 		}
@@ -264,8 +236,8 @@ func TrackStep(isActive bool, label string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_15 = []any{cls(isActive, "font-bold")}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_15...)
+		var var_12 = []any{cls(isActive, "font-bold")}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_12...)
 		if err != nil {
 			return err
 		}
@@ -273,7 +245,7 @@ func TrackStep(isActive bool, label string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_15).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_12).String()))
 		if err != nil {
 			return err
 		}
@@ -281,8 +253,8 @@ func TrackStep(isActive bool, label string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_16 string = label
-		_, err = templBuffer.WriteString(templ.EscapeString(var_16))
+		var var_13 string = label
+		_, err = templBuffer.WriteString(templ.EscapeString(var_13))
 		if err != nil {
 			return err
 		}
@@ -305,9 +277,9 @@ func ExampleTrack(currentStep int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_17 := templ.GetChildren(ctx)
-		if var_17 == nil {
-			var_17 = templ.NopComponent
+		var_14 := templ.GetChildren(ctx)
+		if var_14 == nil {
+			var_14 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<div id=\"tracker\" hx-get=\"")
@@ -336,8 +308,8 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_18 = []any{"h-6 w-4 -mt-2 ml-2 -z-index-100 " + ifc(currentStep >= 2, "bg-lime-400", "bg-stone-200")}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_18...)
+		var var_15 = []any{"h-6 w-4 -mt-2 ml-2 -z-index-100 " + ifc(currentStep >= 2, "bg-lime-400", "bg-stone-200")}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_15...)
 		if err != nil {
 			return err
 		}
@@ -345,7 +317,7 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_18).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_15).String()))
 		if err != nil {
 			return err
 		}
@@ -353,8 +325,8 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_19 = []any{"h-6 w-4 bg-stone-200 -mb-2 ml-2 -z-index-100 " + ifc(currentStep >= 3, "bg-lime-400", "bg-stone-200")}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_19...)
+		var var_16 = []any{"h-6 w-4 bg-stone-200 -mb-2 ml-2 -z-index-100 " + ifc(currentStep >= 3, "bg-lime-400", "bg-stone-200")}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_16...)
 		if err != nil {
 			return err
 		}
@@ -362,7 +334,7 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_19).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_16).String()))
 		if err != nil {
 			return err
 		}
@@ -374,8 +346,8 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_20 = []any{"h-6 w-4 -mt-2 ml-2 " + ifc(currentStep >= 5, "bg-lime-400", "bg-stone-200")}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_20...)
+		var var_17 = []any{"h-6 w-4 -mt-2 ml-2 " + ifc(currentStep >= 5, "bg-lime-400", "bg-stone-200")}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_17...)
 		if err != nil {
 			return err
 		}
@@ -383,7 +355,7 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_20).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_17).String()))
 		if err != nil {
 			return err
 		}
@@ -391,8 +363,8 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_21 = []any{"h-6 w-4 bg-stone-200 -mb-2 ml-2 " + ifc(currentStep >= 6, "bg-lime-400", "bg-stone-200")}
-		err = templ.RenderCSSItems(ctx, templBuffer, var_21...)
+		var var_18 = []any{"h-6 w-4 bg-stone-200 -mb-2 ml-2 " + ifc(currentStep >= 6, "bg-lime-400", "bg-stone-200")}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_18...)
 		if err != nil {
 			return err
 		}
@@ -400,7 +372,7 @@ func ExampleTrack(currentStep int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_21).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_18).String()))
 		if err != nil {
 			return err
 		}
@@ -417,8 +389,8 @@ func ExampleTrack(currentStep int) templ.Component {
 			return err
 		}
 		if currentStep >= 7 {
-			var var_22 = []any{buttonClasses}
-			err = templ.RenderCSSItems(ctx, templBuffer, var_22...)
+			var var_19 = []any{buttonClasses}
+			err = templ.RenderCSSItems(ctx, templBuffer, var_19...)
 			if err != nil {
 				return err
 			}
@@ -426,7 +398,7 @@ func ExampleTrack(currentStep int) templ.Component {
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_22).String()))
+			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_19).String()))
 			if err != nil {
 				return err
 			}
@@ -434,8 +406,8 @@ func ExampleTrack(currentStep int) templ.Component {
 			if err != nil {
 				return err
 			}
-			var_23 := `Order again`
-			_, err = templBuffer.WriteString(var_23)
+			var_20 := `Order again`
+			_, err = templBuffer.WriteString(var_20)
 			if err != nil {
 				return err
 			}
@@ -463,9 +435,9 @@ func ExampleGetOnLoad(trigger, suffix string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_24 := templ.GetChildren(ctx)
-		if var_24 == nil {
-			var_24 = templ.NopComponent
+		var_21 := templ.GetChildren(ctx)
+		if var_21 == nil {
+			var_21 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<div hx-get=\"/get\" hx-trigger=\"")
@@ -511,17 +483,17 @@ func ExampleIndicator() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_25 := templ.GetChildren(ctx)
-		if var_25 == nil {
-			var_25 = templ.NopComponent
+		var_22 := templ.GetChildren(ctx)
+		if var_22 == nil {
+			var_22 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<div><button hx-post=\"/slow\" hx-indicator=\"#spinner-ind\" class=\"flex flex-row border-2 border-black rounded items-center px-3 py-2 gap-2 disabled:opacity-50 disabled:bg-stone-200 disabled:cursor-not-allowed\" hx-disabled-elt=\"this\">")
 		if err != nil {
 			return err
 		}
-		var_26 := `Send request`
-		_, err = templBuffer.WriteString(var_26)
+		var_23 := `Send request`
+		_, err = templBuffer.WriteString(var_23)
 		if err != nil {
 			return err
 		}
@@ -552,17 +524,17 @@ func ExampleClickToLoadTable() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_27 := templ.GetChildren(ctx)
-		if var_27 == nil {
-			var_27 = templ.NopComponent
+		var_24 := templ.GetChildren(ctx)
+		if var_24 == nil {
+			var_24 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<table class=\"w-full\"><thead><tr><th>")
 		if err != nil {
 			return err
 		}
-		var_28 := `ID`
-		_, err = templBuffer.WriteString(var_28)
+		var_25 := `ID`
+		_, err = templBuffer.WriteString(var_25)
 		if err != nil {
 			return err
 		}
@@ -570,20 +542,12 @@ func ExampleClickToLoadTable() templ.Component {
 		if err != nil {
 			return err
 		}
-		var_29 := `Agent Name`
-		_, err = templBuffer.WriteString(var_29)
-		if err != nil {
-			return err
-		}
-		_, err = templBuffer.WriteString("</th></tr></thead><tbody>")
-		if err != nil {
-			return err
-		}
-		err = ClickToLoadRows(initialRows, 1).Render(ctx, templBuffer)
+		var_26 := `Agent Name`
+		_, err = templBuffer.WriteString(var_26)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</tbody></table>")
+		_, err = templBuffer.WriteString("</th></tr></thead><tbody hx-get=\"/click_to_load\" hx-trigger=\"load\"></tbody></table>")
 		if err != nil {
 			return err
 		}
@@ -594,7 +558,7 @@ func ExampleClickToLoadTable() templ.Component {
 	})
 }
 
-func ExampleOpenModal() templ.Component {
+func ExampleInfiniteScroll() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -602,17 +566,17 @@ func ExampleOpenModal() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_30 := templ.GetChildren(ctx)
-		if var_30 == nil {
-			var_30 = templ.NopComponent
+		var_27 := templ.GetChildren(ctx)
+		if var_27 == nil {
+			var_27 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
 		_, err = templBuffer.WriteString("<table class=\"w-full\"><thead><tr><th>")
 		if err != nil {
 			return err
 		}
-		var_31 := `ID`
-		_, err = templBuffer.WriteString(var_31)
+		var_28 := `ID`
+		_, err = templBuffer.WriteString(var_28)
 		if err != nil {
 			return err
 		}
@@ -620,20 +584,20 @@ func ExampleOpenModal() templ.Component {
 		if err != nil {
 			return err
 		}
-		var_32 := `Agent Name`
-		_, err = templBuffer.WriteString(var_32)
+		var_29 := `Agent Name`
+		_, err = templBuffer.WriteString(var_29)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</th></tr></thead><tbody>")
+		_, err = templBuffer.WriteString("</th></tr></thead><tbody hx-get=\"/click_to_load?mode=scroll&amp;size=5\" hx-trigger=\"load\"></tbody></table><div class=\"flex justify-center\">")
 		if err != nil {
 			return err
 		}
-		err = ClickToLoadRows(initialRows, 1).Render(ctx, templBuffer)
+		err = Spinner("scroll").Render(ctx, templBuffer)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</tbody></table>")
+		_, err = templBuffer.WriteString("</div>")
 		if err != nil {
 			return err
 		}
@@ -644,7 +608,7 @@ func ExampleOpenModal() templ.Component {
 	})
 }
 
-func Example(title, description string) templ.Component {
+func Example(title, slug, description string, tags []string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -652,21 +616,30 @@ func Example(title, description string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_33 := templ.GetChildren(ctx)
-		if var_33 == nil {
-			var_33 = templ.NopComponent
+		var_30 := templ.GetChildren(ctx)
+		if var_30 == nil {
+			var_30 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		_, err = templBuffer.WriteString("<div class=\"w-72 bg-white p-4 rounded-lg shadow-md\"><div class=\"flex flex-row justify-between items-center\"><h2 class=\"text-xl font-semibold mb-2\">")
+		_, err = templBuffer.WriteString("<div class=\"w-72 bg-white p-4 rounded-lg shadow-md\"><div class=\"flex flex-row justify-between items-center\"><h2 class=\"text-xl font-semibold mb-2\"><a href=\"")
+		if err != nil {
+			return err
+		}
+		var var_31 templ.SafeURL = templ.URL("/examples/" + slug)
+		_, err = templBuffer.WriteString(templ.EscapeString(string(var_31)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" class=\"hover:underline\">")
 		if err != nil {
 			return err
 		}
-		var var_34 string = title
-		_, err = templBuffer.WriteString(templ.EscapeString(var_34))
+		var var_32 string = title
+		_, err = templBuffer.WriteString(templ.EscapeString(var_32))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</h2>")
+		_, err = templBuffer.WriteString("</a></h2>")
 		if err != nil {
 			return err
 		}
@@ -678,7 +651,7 @@ func Example(title, description string) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = var_33.Render(ctx, templBuffer)
+		err = var_30.Render(ctx, templBuffer)
 		if err != nil {
 			return err
 		}
@@ -686,8 +659,35 @@ func Example(title, description string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_35 string = description
-		_, err = templBuffer.WriteString(templ.EscapeString(var_35))
+		var var_33 string = description
+		_, err = templBuffer.WriteString(templ.EscapeString(var_33))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div><div class=\"flex flex-row flex-wrap gap-1 mt-2\">")
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			_, err = templBuffer.WriteString("<span class=\"text-xs bg-stone-200 rounded px-2 py-1\">")
+			if err != nil {
+				return err
+			}
+			var var_34 string = tag
+			_, err = templBuffer.WriteString(templ.EscapeString(var_34))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</div><div class=\"mt-3\">")
+		if err != nil {
+			return err
+		}
+		err = SnippetToggle(slug).Render(ctx, templBuffer)
 		if err != nil {
 			return err
 		}
@@ -701,3 +701,137 @@ func Example(title, description string) templ.Component {
 		return err
 	})
 }
+
+func ExamplePage(serverVersion string, example ExampleCard, snippets []Snippet, related []ExampleCard) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_35 := templ.GetChildren(ctx)
+		if var_35 == nil {
+			var_35 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_36 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<div class=\"bg-white p-4 rounded-lg shadow-md max-w-3xl\"><h2 class=\"text-2xl font-semibold mb-2\">")
+			if err != nil {
+				return err
+			}
+			var var_37 string = example.Title
+			_, err = templBuffer.WriteString(templ.EscapeString(var_37))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</h2><div class=\"mb-4\">")
+			if err != nil {
+				return err
+			}
+			var var_38 string = example.Description
+			_, err = templBuffer.WriteString(templ.EscapeString(var_38))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+			err = example.Component.Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("<h3 class=\"text-xl font-semibold mt-6\">")
+			if err != nil {
+				return err
+			}
+			var_39 := `Source`
+			_, err = templBuffer.WriteString(var_39)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</h3>")
+			if err != nil {
+				return err
+			}
+			err = Snippets(snippets).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div> ")
+			if err != nil {
+				return err
+			}
+			if len(related) > 0 {
+				_, err = templBuffer.WriteString("<h3 class=\"text-xl font-semibold mt-6\">")
+				if err != nil {
+					return err
+				}
+				var_40 := `Related examples`
+				_, err = templBuffer.WriteString(var_40)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</h3> <ul class=\"list-disc list-inside ml-5\" hx-boost=\"true\">")
+				if err != nil {
+					return err
+				}
+				for _, r := range related {
+					_, err = templBuffer.WriteString("<li><a href=\"")
+					if err != nil {
+						return err
+					}
+					var var_41 templ.SafeURL = templ.URL("/examples/" + r.Slug)
+					_, err = templBuffer.WriteString(templ.EscapeString(string(var_41)))
+					if err != nil {
+						return err
+					}
+					_, err = templBuffer.WriteString("\" class=\"underline\">")
+					if err != nil {
+						return err
+					}
+					var var_42 string = r.Title
+					_, err = templBuffer.WriteString(templ.EscapeString(var_42))
+					if err != nil {
+						return err
+					}
+					_, err = templBuffer.WriteString("</a> ")
+					if err != nil {
+						return err
+					}
+					var var_43 string = " - " + r.Description
+					_, err = templBuffer.WriteString(templ.EscapeString(var_43))
+					if err != nil {
+						return err
+					}
+					_, err = templBuffer.WriteString("</li>")
+					if err != nil {
+						return err
+					}
+				}
+				_, err = templBuffer.WriteString("</ul>")
+				if err != nil {
+					return err
+				}
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = Layout(example.Title+" | HTMX Examples", serverVersion).Render(templ.WithChildren(ctx, var_36), templBuffer)
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
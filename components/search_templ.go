@@ -0,0 +1,205 @@
+// Code generated by templ@v0.2.364 DO NOT EDIT.
+
+package components
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+import "strconv"
+import "strings"
+import "github.com/magnuswahlstrand/htmx-experiments/types"
+
+type highlightPart struct {
+	Text  string
+	Match bool
+}
+
+// highlight splits s into parts, marking every case-insensitive occurrence of query as a match.
+func highlight(s, query string) []highlightPart {
+	lower, lowerQuery := strings.ToLower(s), strings.ToLower(query)
+	// Lowercasing may change the byte length of some runes, in which case the indexes no longer line up.
+	if query == "" || len(lower) != len(s) {
+		return []highlightPart{{Text: s}}
+	}
+	var parts []highlightPart
+	for {
+		i := strings.Index(lower, lowerQuery)
+		if i < 0 {
+			break
+		}
+		end := i + len(lowerQuery)
+		if i > 0 {
+			parts = append(parts, highlightPart{Text: s[:i]})
+		}
+		parts = append(parts, highlightPart{Text: s[i:end], Match: true})
+		s, lower = s[end:], lower[end:]
+	}
+	if s != "" {
+		parts = append(parts, highlightPart{Text: s})
+	}
+	return parts
+}
+
+func Highlighted(s, query string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		for _, part := range highlight(s, query) {
+			if part.Match {
+				_, err = templBuffer.WriteString("<mark class=\"bg-yellow-200\">")
+				if err != nil {
+					return err
+				}
+				var var_2 string = part.Text
+				_, err = templBuffer.WriteString(templ.EscapeString(var_2))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</mark>")
+				if err != nil {
+					return err
+				}
+			} else {
+				var var_3 string = part.Text
+				_, err = templBuffer.WriteString(templ.EscapeString(var_3))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func SearchResults(agents []types.Agent, query string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_4 := templ.GetChildren(ctx)
+		if var_4 == nil {
+			var_4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if len(agents) == 0 {
+			_, err = templBuffer.WriteString("<tr><td colspan=\"2\" class=\"text-center italic text-stone-500 py-2\">")
+			if err != nil {
+				return err
+			}
+			var_5 := `No agents matching "`
+			_, err = templBuffer.WriteString(var_5)
+			if err != nil {
+				return err
+			}
+			var var_6 string = query
+			_, err = templBuffer.WriteString(templ.EscapeString(var_6))
+			if err != nil {
+				return err
+			}
+			var_7 := `"`
+			_, err = templBuffer.WriteString(var_7)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</td></tr>")
+			if err != nil {
+				return err
+			}
+		}
+		for _, agent := range agents {
+			_, err = templBuffer.WriteString("<tr><td class=\"text-center\">")
+			if err != nil {
+				return err
+			}
+			err = Highlighted(strconv.Itoa(agent.ID), query).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</td><td class=\"text-center\">")
+			if err != nil {
+				return err
+			}
+			err = Highlighted(agent.Name, query).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</td></tr>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func ExampleActiveSearch() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_8 := templ.GetChildren(ctx)
+		if var_8 == nil {
+			var_8 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div class=\"flex flex-row items-center gap-2\"><input type=\"search\" name=\"q\" placeholder=\"Search agents...\" hx-get=\"/search\" hx-trigger=\"keyup changed delay:300ms, search\" hx-target=\"#search-results\" hx-indicator=\"#spinner-search\" class=\"shadow appearance-none border rounded w-full py-2 px-3 text-gray-700 leading-tight focus:outline-none focus:shadow-outline\">")
+		if err != nil {
+			return err
+		}
+		err = Spinner("search").Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div><table class=\"w-full mt-2\"><thead><tr><th>")
+		if err != nil {
+			return err
+		}
+		var_9 := `ID`
+		_, err = templBuffer.WriteString(var_9)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</th><th>")
+		if err != nil {
+			return err
+		}
+		var_10 := `Agent Name`
+		_, err = templBuffer.WriteString(var_10)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</th></tr></thead><tbody id=\"search-results\" hx-get=\"/search\" hx-trigger=\"load\"></tbody></table>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
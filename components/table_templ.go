@@ -0,0 +1,441 @@
+// Code generated by templ@v0.2.364 DO NOT EDIT.
+
+package components
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+import "fmt"
+import "net/url"
+import "strconv"
+import "github.com/magnuswahlstrand/htmx-experiments/types"
+
+// AgentsTableID is the id of the agents table, which is the target of every request that
+// changes the view of the table.
+const AgentsTableID = "agents-table"
+
+// AgentsTableQuery returns the query string describing the view q.
+func AgentsTableQuery(q types.AgentQuery) string {
+	v := url.Values{}
+	v.Set("sort", q.Sort)
+	if q.Desc {
+		v.Set("dir", "desc")
+	} else {
+		v.Set("dir", "asc")
+	}
+	if q.ID != "" {
+		v.Set("id", q.ID)
+	}
+	if q.Name != "" {
+		v.Set("name", q.Name)
+	}
+	v.Set("page", strconv.Itoa(q.Page))
+	v.Set("size", strconv.Itoa(q.Size))
+	return v.Encode()
+}
+
+// AgentsTableURL returns the URL of the agents table showing the view q.
+func AgentsTableURL(q types.AgentQuery) string {
+	return "/agents?" + AgentsTableQuery(q)
+}
+
+// sortedBy returns q sorted by column, toggling the direction if q is already sorted by it.
+func sortedBy(q types.AgentQuery, column string) types.AgentQuery {
+	q.Desc = q.Sort == column && !q.Desc
+	q.Sort = column
+	q.Page = 1
+	return q
+}
+
+func atPage(q types.AgentQuery, page int) types.AgentQuery {
+	q.Page = page
+	return q
+}
+
+func sortIndicator(q types.AgentQuery, column string) string {
+	switch {
+	case q.Sort != column:
+		return "↕"
+	case q.Desc:
+		return "▼"
+	default:
+		return "▲"
+	}
+}
+
+func AgentsTableHeader(q types.AgentQuery, column, title string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<th><button type=\"button\" class=\"font-bold\" hx-get=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(AgentsTableURL(sortedBy(q, column))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var var_2 string = title
+		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(" <span class=\"text-stone-400\">")
+		if err != nil {
+			return err
+		}
+		var var_3 string = sortIndicator(q, column)
+		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span></button></th>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+// AgentsTable is a sortable and filterable table of agents. Typing in a filter or changing the
+// page size submits the form, while the headers and page buttons request the URL of their view.
+
+func AgentsTable(q types.AgentQuery, agents []types.Agent, total int, sizes []int) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_4 := templ.GetChildren(ctx)
+		if var_4 == nil {
+			var_4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<form id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(AgentsTableID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-get=\"/agents\" hx-trigger=\"input delay:300ms\" hx-target=\"this\" hx-swap=\"outerHTML\"><input type=\"hidden\" name=\"sort\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(q.Sort))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		if q.Desc {
+			_, err = templBuffer.WriteString("<input type=\"hidden\" name=\"dir\" value=\"desc\">")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("<table class=\"w-full\"><thead><tr>")
+		if err != nil {
+			return err
+		}
+		err = AgentsTableHeader(q, "id", "ID").Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		err = AgentsTableHeader(q, "name", "Agent Name").Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</tr><tr><th class=\"px-1\">")
+		if err != nil {
+			return err
+		}
+		var var_5 = []any{templ.SafeClass(inputClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_5...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<input id=\"agents-filter-id\" type=\"search\" name=\"id\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(q.ID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" placeholder=\"Filter...\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_5).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></th><th class=\"px-1\">")
+		if err != nil {
+			return err
+		}
+		var var_6 = []any{templ.SafeClass(inputClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_6...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<input id=\"agents-filter-name\" type=\"search\" name=\"name\" value=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(q.Name))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" placeholder=\"Filter...\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_6).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></th></tr></thead><tbody>")
+		if err != nil {
+			return err
+		}
+		if len(agents) == 0 {
+			_, err = templBuffer.WriteString("<tr><td colspan=\"2\" class=\"text-center italic text-stone-500 py-2\">")
+			if err != nil {
+				return err
+			}
+			var_7 := `No matching agents`
+			_, err = templBuffer.WriteString(var_7)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</td></tr>")
+			if err != nil {
+				return err
+			}
+		}
+		for _, agent := range agents {
+			_, err = templBuffer.WriteString("<tr>")
+			if err != nil {
+				return err
+			}
+			err = AgentRow(agent).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</tr>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</tbody></table><div class=\"flex flex-row items-center justify-between gap-2 mt-2\"><label>")
+		if err != nil {
+			return err
+		}
+		var_8 := `Rows per page`
+		_, err = templBuffer.WriteString(var_8)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(" <select id=\"agents-size\" name=\"size\" class=\"border rounded px-2 py-1\">")
+		if err != nil {
+			return err
+		}
+		for _, size := range sizes {
+			_, err = templBuffer.WriteString("<option value=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(size)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"")
+			if err != nil {
+				return err
+			}
+			if size == q.Size {
+				_, err = templBuffer.WriteString(" selected")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString(">")
+			if err != nil {
+				return err
+			}
+			var var_9 string = strconv.Itoa(size)
+			_, err = templBuffer.WriteString(templ.EscapeString(var_9))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</option>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</select></label><span>")
+		if err != nil {
+			return err
+		}
+		var var_10 string = pageSummary(q, len(agents), total)
+		_, err = templBuffer.WriteString(templ.EscapeString(var_10))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span><div class=\"flex flex-row gap-2\">")
+		if err != nil {
+			return err
+		}
+		var var_11 = []any{templ.SafeClass(buttonClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_11...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<button type=\"button\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_11).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"")
+		if err != nil {
+			return err
+		}
+		if q.Page <= 1 {
+			_, err = templBuffer.WriteString(" disabled")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString(" hx-get=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(AgentsTableURL(atPage(q, q.Page-1))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_12 := `Previous`
+		_, err = templBuffer.WriteString(var_12)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button>")
+		if err != nil {
+			return err
+		}
+		var var_13 = []any{templ.SafeClass(buttonClasses)}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_13...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<button type=\"button\" class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_13).String()))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"")
+		if err != nil {
+			return err
+		}
+		if q.Page*q.Size >= total {
+			_, err = templBuffer.WriteString(" disabled")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString(" hx-get=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(AgentsTableURL(atPage(q, q.Page+1))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_14 := `Next`
+		_, err = templBuffer.WriteString(var_14)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></div></div></form>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func pageSummary(q types.AgentQuery, rows, total int) string {
+	if rows == 0 {
+		return "0 agents"
+	}
+	first := (q.Page-1)*q.Size + 1
+	return fmt.Sprintf("%d–%d of %d", first, first+rows-1, total)
+}
+
+func ExampleDataTable() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_15 := templ.GetChildren(ctx)
+		if var_15 == nil {
+			var_15 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div hx-get=\"/agents\" hx-trigger=\"load\" hx-swap=\"outerHTML\"></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/magnuswahlstrand/htmx-experiments/sse"
+)
+
+// watchDebounce groups the bursts of events editors and generators produce when saving.
+const watchDebounce = 100 * time.Millisecond
+
+// watchFiles publishes reload events to the reload topic whenever a file in dirs changes. If
+// only CSS files changed the stylesheet is swapped in place, otherwise the page is refreshed.
+// dirs should only hold files the running server serves from disk, since a refresh for anything
+// that is compiled in would load the old content before the server is rebuilt.
+func watchFiles(hub *sse.Hub, dirs ...string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return err
+		}
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var timer <-chan time.Time
+		cssOnly := true
+		for {
+			select {
+			case e, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if e.Op == fsnotify.Chmod {
+					continue
+				}
+				cssOnly = cssOnly && filepath.Ext(e.Name) == ".css"
+				timer = time.After(watchDebounce)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("file watcher: %v", err)
+			case <-timer:
+				if cssOnly {
+					log.Printf("css changed, reloading stylesheet")
+					hub.Publish(reloadTopic, sse.Event{Name: "ReloadCSS", Data: stylesheetLink()})
+				} else {
+					log.Printf("files changed, refreshing page")
+					hub.Publish(reloadTopic, sse.Event{Name: "Refresh"})
+				}
+				timer = nil
+				cssOnly = true
+			}
+		}
+	}()
+	return nil
+}
+
+// stylesheetLink returns a cache-busting replacement for the stylesheet link in the page head.
+func stylesheetLink() string {
+	return `<link id="styles" rel="stylesheet" href="/styles.css?v=` + strconv.FormatInt(time.Now().UnixMilli(), 10) + `" />`
+}
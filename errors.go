@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+	"github.com/magnuswahlstrand/htmx-experiments/htmx"
+	"github.com/magnuswahlstrand/htmx-experiments/render"
+)
+
+// errorHandler renders errors as a toast in the page's toast area for htmx requests, and as a
+// full error page otherwise. The response-targets extension on the page body makes htmx swap
+// the 4xx and 5xx responses it would otherwise ignore.
+func errorHandler(c *fiber.Ctx, err error) error {
+	code := fiber.StatusInternalServerError
+	message := "Something went wrong"
+	var e *fiber.Error
+	if errors.As(err, &e) {
+		code = e.Code
+		message = e.Message
+	} else {
+		log.Printf("%s %s: %v", c.Method(), c.Path(), err)
+	}
+
+	c.Vary("HX-Request")
+	if wantsFullPage(c) {
+		w := templts.ErrorPage(serverVersion, code, message)
+		return render.Render(c, w, render.WithStatus(code))
+	}
+	htmx.Retarget(c, "#toasts")
+	htmx.Reswap(c, htmx.BeforeEnd)
+	w := templts.ErrorToast(code, message)
+	return render.Render(c, w, render.WithStatus(code))
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+	"github.com/magnuswahlstrand/htmx-experiments/render"
+)
+
+type route struct {
+	Method  string
+	Path    string
+	Handler fiber.Handler
+}
+
+// example is a single entry in the registry. Adding an example means adding an entry here;
+// its routes are mounted and its card is rendered on the index page from this list.
+type example struct {
+	templts.ExampleCard
+	Routes []route
+	// Sources lists the templ components shown in the source snippet, next to the route handlers.
+	Sources []string
+}
+
+var examples = []example{
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "mouseover",
+			Title:       "mouseover",
+			Description: "The box will fetch a new color from the server when you hover it",
+			Tags:        []string{"hx-trigger", "hx-swap"},
+			Component:   templts.Color("mouseenter", "bg-red-500", true),
+		},
+		Routes:  []route{{fiber.MethodGet, "/color", colorHandler}},
+		Sources: []string{"Color"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "get-on-load",
+			Title:       "get on load",
+			Description: "Fetches a new message from the server when the page loads",
+			Tags:        []string{"hx-trigger", "hx-indicator"},
+			Component:   templts.ExampleGetOnLoad("load", ""),
+		},
+		Routes:  []route{{fiber.MethodGet, "/get", getHandler}},
+		Sources: []string{"ExampleGetOnLoad"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "hx-indicator",
+			Title:       "hx-indicator",
+			Description: "Uses the 'hx-indicator' attribute to show a loading indicator and the 'hx-disabled-elt' attribute to disable the button while the request is in flight",
+			Tags:        []string{"hx-indicator", "hx-disabled-elt"},
+			Component:   templts.ExampleIndicator(),
+		},
+		Routes:  []route{{fiber.MethodPost, "/slow", slowHandler}},
+		Sources: []string{"ExampleIndicator"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "click-to-load",
+			Title:       "click to load",
+			Description: "Click the button to load more rows from the server",
+			Tags:        []string{"hx-swap", "hx-indicator", "tables"},
+			Component:   templts.ExampleClickToLoadTable(),
+		},
+		Routes:  []route{{fiber.MethodGet, "/click_to_load", clickToLoadHandler}},
+		Sources: []string{"ExampleClickToLoadTable", "ClickToLoadRows"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "infinite-scroll",
+			Title:       "infinite scroll",
+			Description: "Loads the next page of agents when the last row scrolls into view, until the end of the list",
+			Tags:        []string{"hx-trigger", "hx-swap", "tables"},
+			Component:   templts.ExampleInfiniteScroll(),
+		},
+		Routes:  []route{{fiber.MethodGet, "/click_to_load", clickToLoadHandler}},
+		Sources: []string{"ExampleInfiniteScroll", "ClickToLoadRows"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "data-table",
+			Title:       "data table",
+			Description: "Sort by clicking the headers, filter each column and pick a page size. The view is kept in the URL, so it can be shared and survives a reload",
+			Tags:        []string{"hx-trigger", "HX-Push-Url", "tables"},
+			Component:   templts.ExampleDataTable(),
+		},
+		Routes:  []route{{fiber.MethodGet, "/agents", agentsTableHandler}},
+		Sources: []string{"ExampleDataTable", "AgentsTable", "AgentsTableHeader"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "open-modal",
+			Title:       "open modal",
+			Description: "Will open a modal when you click the button",
+			Tags:        []string{"hx-swap", "hyperscript"},
+			Component:   templts.ExampleOpenModal(),
+		},
+		Routes:  []route{{fiber.MethodGet, "/modal", modalHandler}},
+		Sources: []string{"ExampleOpenModal", "Modal"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "click-to-edit",
+			Title:       "click to edit",
+			Description: "Sends form to the backend directly when click the Submit button and returns the server state. Fields are validated as you leave them",
+			Tags:        []string{"forms", "contacts"},
+			Component:   templts.ExampleClickToEdit(),
+		},
+		Routes: []route{
+			{fiber.MethodGet, "/click_to_edit", clickToEditHandler},
+			{fiber.MethodGet, "/contacts/:id<int>", contactGetHandler},
+			{fiber.MethodGet, "/contacts/:id<int>/edit", contactEditGetHandler},
+			{fiber.MethodPut, "/contacts/:id<int>", contactsUpdatePutHandler},
+			{fiber.MethodPost, "/contacts", contactsCreatePostHandler},
+			{fiber.MethodPost, "/contacts/:id<int>/validate/:field", contactValidateHandler},
+			{fiber.MethodGet, "/contacts/:id<int>/history", contactHistoryHandler},
+			{fiber.MethodPost, "/contacts/:id<int>/undo/:change", contactUndoHandler},
+			{fiber.MethodDelete, "/contacts/:id<int>", contactDeleteHandler},
+		},
+		Sources: []string{"ExampleClickToEdit", "ClickToEdit", "ContactForm", "ContactField", "ContactConflict", "ContactHistory", "SavedToast"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "contacts-table",
+			Title:       "contacts table",
+			Description: "Select contacts and activate, deactivate or delete them in one request. Only the changed rows are returned, and they are highlighted as they settle. Deleting a single row asks for confirmation and fades the row out, and one row at a time can be edited in place",
+			Tags:        []string{"hx-swap-oob", "hx-confirm", "tables", "contacts"},
+			Component:   templts.ExampleContactsTable(),
+		},
+		Routes: []route{
+			{fiber.MethodGet, "/contacts", contactsListHandler},
+			{fiber.MethodPut, "/contacts/bulk", contactsBulkUpdateHandler},
+			{fiber.MethodDelete, "/contacts/bulk", contactsBulkDeleteHandler},
+			{fiber.MethodDelete, "/contacts/:id<int>", contactDeleteHandler},
+			{fiber.MethodGet, "/contacts/:id<int>/row", contactRowGetHandler},
+			{fiber.MethodGet, "/contacts/:id<int>/row/edit", contactRowEditGetHandler},
+			{fiber.MethodPut, "/contacts/:id<int>/row", contactRowPutHandler},
+		},
+		Sources: []string{"ContactsTable", "ContactRow", "ContactEditRow", "ContactRowsChanged", "ContactRowsDeleted"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "active-search",
+			Title:       "active search",
+			Description: "Filters the agents on the server as you type, waiting for a 300ms pause in typing before sending the request",
+			Tags:        []string{"hx-trigger", "hx-indicator", "tables"},
+			Component:   templts.ExampleActiveSearch(),
+		},
+		Routes:  []route{{fiber.MethodGet, "/search", searchHandler}},
+		Sources: []string{"ExampleActiveSearch", "SearchResults"},
+	},
+	{
+		ExampleCard: templts.ExampleCard{
+			Slug:        "show-progress",
+			Title:       "show progress",
+			Description: "Tracks a specific order until completion after it has been placed. Stops at completion.",
+			Tags:        []string{"hx-trigger", "polling"},
+			Component:   templts.ExampleShowProgress(),
+		},
+		Routes:  []route{{fiber.MethodGet, "/track", trackHandler}},
+		Sources: []string{"ExampleShowProgress", "ExampleTrack"},
+	},
+}
+
+func exampleBySlug(slug string) (example, bool) {
+	for _, e := range examples {
+		if e.Slug == slug {
+			return e, true
+		}
+	}
+	return example{}, false
+}
+
+func exampleCards() []templts.ExampleCard {
+	cards := make([]templts.ExampleCard, len(examples))
+	for i, e := range examples {
+		cards[i] = e.ExampleCard
+	}
+	return cards
+}
+
+// handlerNames returns the function names of the example's route handlers, e.g. "colorHandler".
+func (e example) handlerNames() []string {
+	var names []string
+	for _, r := range e.Routes {
+		name := runtime.FuncForPC(reflect.ValueOf(r.Handler).Pointer()).Name()
+		names = append(names, name[strings.LastIndex(name, ".")+1:])
+	}
+	return names
+}
+
+// related returns the other examples that share at least one tag with e.
+func (e example) related() []templts.ExampleCard {
+	var cards []templts.ExampleCard
+	for _, other := range examples {
+		if other.Slug == e.Slug {
+			continue
+		}
+		for _, tag := range other.Tags {
+			if slices.Contains(e.Tags, tag) {
+				cards = append(cards, other.ExampleCard)
+				break
+			}
+		}
+	}
+	return cards
+}
+
+func examplePageHandler(c *fiber.Ctx) error {
+	e, ok := exampleBySlug(c.Params("slug"))
+	if !ok {
+		return fiber.NewError(fiber.StatusNotFound, "unknown example")
+	}
+	w := templts.ExamplePage(serverVersion, e.ExampleCard, exampleSnippets[e.Slug], e.related())
+	return render.Render(c, w)
+}
+
+func mountExamples(app *fiber.App) {
+	app.Get("/examples/:slug", examplePageHandler)
+	// Examples may share routes, which are only mounted once.
+	mounted := map[string]bool{}
+	for _, e := range examples {
+		for _, r := range e.Routes {
+			if mounted[r.Method+" "+r.Path] {
+				continue
+			}
+			mounted[r.Method+" "+r.Path] = true
+			app.Add(r.Method, r.Path, r.Handler)
+		}
+	}
+}
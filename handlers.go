@@ -2,16 +2,26 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"github.com/a-h/templ"
 	"github.com/gofiber/fiber/v2"
 	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+	"github.com/magnuswahlstrand/htmx-experiments/htmx"
+	"github.com/magnuswahlstrand/htmx-experiments/render"
+	"github.com/magnuswahlstrand/htmx-experiments/sse"
+	"github.com/magnuswahlstrand/htmx-experiments/store"
 	"github.com/magnuswahlstrand/htmx-experiments/types"
 	"github.com/valyala/fasthttp"
+	"log"
 	"net/http"
+	"net/mail"
+	"net/url"
 	"slices"
 	"strconv"
-	"sync"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var colors = []string{
@@ -33,52 +43,58 @@ func colorHandler(c *fiber.Ctx) error {
 	color := colors[(currentIndex+1)%len(colors)]
 
 	w := templts.Color(trigger, color, animate == "true")
-	return w.Render(c.Context(), c.Response().BodyWriter())
+	return renderFragment(c, w)
 }
 
 func trackHandler(c *fiber.Ctx) error {
 	currentState, err := strconv.Atoi(c.Query("state", "0"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	w := templts.ExampleTrack(currentState)
-	return w.Render(c.Context(), c.Response().BodyWriter())
+	return renderFragment(c, w)
 }
 
 func getHandler(c *fiber.Ctx) error {
 	return c.SendString("Hello from server")
 }
 
-var serverVersion = strconv.FormatInt(time.Now().Unix(), 10)
-
 func reloadHandler(c *fiber.Ctx) error {
-	clientVersion := c.Query("timestamp", "")
-	if clientVersion != serverVersion {
-		c.Set("HX-Refresh", "true")
+	clientVersion := c.Query("version", "")
+	if c.QueryBool("force") || clientVersion != "" && clientVersion != serverVersion {
+		htmx.Refresh(c)
 	}
 	return c.SendString("")
 }
 
+var hub = sse.NewHub()
+
+const reloadTopic = "reload"
+
+// sseHandler streams the events of the topics given by the "topic" query parameters, defaulting
+// to the reload topic. Events missed since the Last-Event-ID header are replayed first, then a
+// TriggerReload event is sent so that a reconnecting client checks whether the server version
+// has changed.
 func sseHandler(c *fiber.Ctx) error {
+	var topics []string
+	for _, topic := range c.Context().QueryArgs().PeekMulti("topic") {
+		topics = append(topics, string(topic))
+	}
+	if len(topics) == 0 {
+		topics = []string{reloadTopic}
+	}
+
 	c.Set("Content-Type", "text/event-stream")
 	c.Set("Cache-Control", "no-cache")
 	c.Set("Connection", "keep-alive")
 	c.Set("Transfer-Encoding", "chunked")
 
+	lastEventID, _ := strconv.ParseUint(c.Get("Last-Event-ID"), 10, 64)
+	connected := sse.Event{Name: "TriggerReload", Data: "Connected at " + time.Now().Format(time.RFC3339)}
+	sub := hub.Subscribe(lastEventID, []sse.Event{connected}, topics...)
 	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
-		var i int
-		msg := fmt.Sprintf("%d - the 2time is %v", i, time.Now())
-		fmt.Fprintf(w, "event: TriggerReload\n")
-		fmt.Fprintf(w, "data: Message: %s\n\n", msg)
-		err := w.Flush()
-		for {
-			i++
-			if err != nil {
-				fmt.Printf("Error while flushing: %v. Closing http connection.\n", err)
-				break
-			}
-			// TODO: lock here forever, instead?
-			time.Sleep(1 * time.Second)
+		if err := hub.Stream(w, sub); err != nil {
+			log.Printf("Closing sse connection: %v", err)
 		}
 	}))
 
@@ -90,57 +106,695 @@ func slowHandler(ctx *fiber.Ctx) error {
 	return ctx.SendStatus(http.StatusNoContent)
 }
 
+const (
+	defaultPageSize = 2
+	maxPageSize     = 50
+)
+
+// clickToLoadHandler returns the page of agents after the "cursor" query parameter. With
+// mode=scroll the last row loads the next page when it is scrolled into view, otherwise a
+// button is shown to load it.
 func clickToLoadHandler(c *fiber.Ctx) error {
 	time.Sleep(100 * time.Millisecond)
 
-	pageStr := c.Query("page", "0")
-	page, err := strconv.Atoi(pageStr)
+	size := c.QueryInt("size", defaultPageSize)
+	if size < 1 || size > maxPageSize {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("size must be between 1 and %d", maxPageSize))
+	}
+	page, next, err := agentSource.Page(c.Query("cursor"), size)
+	if errors.Is(err, store.ErrInvalidCursor) {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	w := templts.ClickToLoadRows(page, next, size, c.Query("mode") == "scroll")
+	return renderRows(c, w)
+}
+
+var agentNames = []string{"Smith", "Jones", "Brown", "Johnson", "Thompson", "Jackson", "White", "Gray"}
+
+var agents = func() []types.Agent {
+	var agents []types.Agent
+	for i := 1; i <= 24; i++ {
+		name := "Agent " + agentNames[(i-1)%len(agentNames)] + " #" + strconv.Itoa(i)
+		agents = append(agents, types.Agent{ID: i, Name: name})
+	}
+	return agents
+}()
+
+var agentSource = store.NewAgentSource(agents)
+
+func searchHandler(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q", ""))
+	lowerQuery := strings.ToLower(query)
+
+	var matches []types.Agent
+	for _, agent := range agentSource.All() {
+		if strings.Contains(strings.ToLower(agent.Name), lowerQuery) || strings.Contains(strconv.Itoa(agent.ID), query) {
+			matches = append(matches, agent)
+		}
+	}
+
+	w := templts.SearchResults(matches, query)
+	return renderRows(c, w)
+}
+
+// agentTableSizes are the page sizes offered by the agents table.
+var agentTableSizes = []int{5, 10, 25}
+
+// dataTablePage is the page of the data table example, whose URL keeps the view of the table.
+const dataTablePage = "/examples/data-table"
+
+func parseAgentQuery(values url.Values) (types.AgentQuery, error) {
+	q := types.AgentQuery{
+		Sort: values.Get("sort"),
+		Desc: values.Get("dir") == "desc",
+		ID:   strings.TrimSpace(values.Get("id")),
+		Name: strings.TrimSpace(values.Get("name")),
+		Page: 1,
+		Size: agentTableSizes[1],
+	}
+	if v := values.Get("page"); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil {
+			return q, fiber.NewError(fiber.StatusBadRequest, "page must be a number")
+		}
+		q.Page = max(page, 1)
+	}
+	if v := values.Get("size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || !slices.Contains(agentTableSizes, size) {
+			return q, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("size must be one of %v", agentTableSizes))
+		}
+		q.Size = size
+	}
+	if q.Sort == "" {
+		q.Sort = "id"
+	}
+	if q.Sort != "id" && q.Sort != "name" {
+		return q, fiber.NewError(fiber.StatusBadRequest, "sort must be id or name")
+	}
+	return q, nil
+}
+
+// agentsTableHandler renders the agents table for the view described by the query string. The
+// view is kept in the URL of the data table page, or of the table itself when opened directly,
+// so that it can be shared and is restored on reload. The index page shows every example, so
+// its URL is left alone.
+func agentsTableHandler(c *fiber.Ctx) error {
+	req := htmx.GetRequest(c)
+	current, _ := url.Parse(req.CurrentURL)
+	if current == nil {
+		current = &url.URL{}
+	}
+
+	values, err := url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if req.Target != templts.AgentsTableID && current.Path == dataTablePage {
+		// The table is loading on the data table page, which may have been opened with a view.
+		values = current.Query()
+	}
+	q, err := parseAgentQuery(values)
+	if err != nil {
+		return err
+	}
+
+	page, total := agentSource.Query(q)
+	if len(page) == 0 && q.Page > 1 {
+		// The filters left fewer pages than the requested one, show the last page instead.
+		q.Page = max((total+q.Size-1)/q.Size, 1)
+		page, total = agentSource.Query(q)
 	}
 
-	agentID := 2*page + 1
-	w := templts.ClickToLoadRows([]int{agentID, agentID + 1}, page+1)
-	return w.Render(c.Context(), c.Response().BodyWriter())
+	if req.Target == templts.AgentsTableID && (current.Path == dataTablePage || current.Path == "/agents") {
+		htmx.PushURL(c, current.Path+"?"+templts.AgentsTableQuery(q))
+	}
+	w := templts.AgentsTable(q, page, total, agentTableSizes)
+	return renderFragment(c, w)
 }
 
 func modalHandler(c *fiber.Ctx) error {
 	w := templts.Modal()
-	return w.Render(c.Context(), c.Response().BodyWriter())
+	return renderFragment(c, w)
+}
+
+// seedContacts are the contacts every visitor starts out with.
+var seedContacts = []types.Contact{
+	{Name: "Magnus", Email: "magnus@mail.com", Active: true},
+	{Name: "Ada", Email: "ada@mail.com", Active: true},
+	{Name: "Grace", Email: "grace@mail.com", Active: false},
+	{Name: "Linus", Email: "linus@mail.com", Active: true},
+	{Name: "Barbara", Email: "barbara@mail.com", Active: false},
+}
+
+func contactID(c *fiber.Ctx) (int, error) {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	return id, nil
+}
+
+// contactETag identifies the version of a contact. It is sent with rendered contacts, so clients
+// can base an update on it with If-Match instead of the version form field.
+func contactETag(contact types.Contact) string {
+	return `"` + strconv.Itoa(contact.Version) + `"`
+}
+
+func contactError(err error) error {
+	if errors.Is(err, store.ErrNotFound) {
+		return fiber.NewError(fiber.StatusNotFound, "contact not found")
+	}
+	return err
+}
+
+const (
+	maxNameLength  = 100
+	maxEmailLength = 254
+)
+
+// validateContact validates all fields of contact. Emails must be unique among the contacts.
+func validateContact(contacts store.ContactStore, contact types.Contact) types.ContactErrors {
+	var errs types.ContactErrors
+
+	switch {
+	case contact.Name == "":
+		errs.Name = "Name is required"
+	case utf8.RuneCountInString(contact.Name) > maxNameLength:
+		errs.Name = fmt.Sprintf("Name must be at most %d characters", maxNameLength)
+	}
+
+	switch addr, err := mail.ParseAddress(contact.Email); {
+	case contact.Email == "":
+		errs.Email = "Email is required"
+	case len(contact.Email) > maxEmailLength:
+		errs.Email = fmt.Sprintf("Email must be at most %d characters", maxEmailLength)
+	case err != nil || addr.Address != contact.Email:
+		errs.Email = "Email is not a valid email address"
+	default:
+		for _, other := range contacts.List() {
+			if other.ID != contact.ID && strings.EqualFold(other.Email, contact.Email) {
+				errs.Email = "Email is already used by another contact"
+				break
+			}
+		}
+	}
+	return errs
 }
 
-var contactMu = &sync.Mutex{}
-var contact = types.Contact{
-	Name:  "Magnus",
-	Email: "magnus@mail.com",
+// parseContact parses the contact form, trimming surrounding whitespace.
+func parseContact(c *fiber.Ctx) (types.Contact, error) {
+	var contact types.Contact
+	if err := c.BodyParser(&contact); err != nil {
+		return types.Contact{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	contact.Name = strings.TrimSpace(contact.Name)
+	contact.Email = strings.TrimSpace(contact.Email)
+	return contact, nil
+}
+
+// clickToEditHandler renders the first contact, since any contact may have been deleted in the
+// contacts table.
+func clickToEditHandler(c *fiber.Ctx) error {
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contacts := state.contacts.List()
+	if len(contacts) == 0 {
+		return renderFragment(c, templts.ClickToEdit(types.Contact{}, false))
+	}
+	c.Set(fiber.HeaderETag, contactETag(contacts[0]))
+	w := templts.ClickToEdit(contacts[0], true)
+	return renderFragment(c, w)
 }
 
 func contactGetHandler(c *fiber.Ctx) error {
-	contactMu.Lock()
-	defer contactMu.Unlock()
-	w := templts.ContactForm(contact, false)
-	return w.Render(c.Context(), c.Response().BodyWriter())
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+	c.Set(fiber.HeaderETag, contactETag(contact))
+	w := templts.ContactForm(contact, false, types.ContactErrors{})
+	return renderFragment(c, w)
 }
 
 func contactEditGetHandler(c *fiber.Ctx) error {
-	contactMu.Lock()
-	defer contactMu.Unlock()
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+	c.Set(fiber.HeaderETag, contactETag(contact))
+	w := templts.ContactForm(contact, true, types.ContactErrors{})
+	return renderFragment(c, w)
+}
+
+func contactsCreatePostHandler(c *fiber.Ctx) error {
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := parseContact(c)
+	if err != nil {
+		return err
+	}
+	if errs := validateContact(state.contacts, contact); errs.Any() {
+		w := templts.ContactForm(contact, true, errs)
+		return renderFragment(c, w, render.WithStatus(fiber.StatusUnprocessableEntity))
+	}
 
-	w := templts.ContactForm(contact, true)
-	return w.Render(c.Context(), c.Response().BodyWriter())
+	contact, err = state.contacts.Create(contact)
+	if err != nil {
+		return err
+	}
+	w := templts.ContactForm(contact, false, types.ContactErrors{})
+	return renderFragment(c, w, render.WithStatus(fiber.StatusCreated))
 }
 
 func contactsUpdatePutHandler(c *fiber.Ctx) error {
-	contactMu.Lock()
-	defer contactMu.Unlock()
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+
+	update, err := parseContact(c)
+	if err != nil {
+		return err
+	}
+	update.ID = id
+	if ifMatch := c.Get(fiber.HeaderIfMatch); ifMatch != "" {
+		version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid If-Match header")
+		}
+		update.Version = version
+	}
+	if errs := validateContact(state.contacts, update); errs.Any() {
+		w := templts.ContactForm(update, true, errs)
+		return renderFragment(c, w, render.WithStatus(fiber.StatusUnprocessableEntity))
+	}
+
+	old, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+	// The form does not include the status, which is changed with the bulk actions instead.
+	update.Active = old.Active
+	contact, err := state.contacts.Update(update)
+	if errors.Is(err, store.ErrConflict) {
+		c.Set(fiber.HeaderETag, contactETag(contact))
+		w := templts.ContactConflict(update, contact)
+		return renderFragment(c, w, render.WithStatus(fiber.StatusConflict))
+	}
+	if err != nil {
+		return contactError(err)
+	}
+	return renderSavedContact(c, state, old, contact)
+}
+
+// recordContactChange records the change in the contact's history. The caller triggers
+// contactChanged once with the ids of all changed contacts, so that views of the contacts
+// elsewhere on the page refresh.
+func recordContactChange(c *fiber.Ctx, state demoState, old, contact types.Contact) (types.ContactChange, error) {
+	change, err := state.history.Record(types.ContactChange{
+		Old:     old,
+		New:     contact,
+		Time:    time.Now(),
+		Session: sessionID(c),
+	})
+	if err != nil {
+		return types.ContactChange{}, err
+	}
+	return change, nil
+}
+
+// deleteContact deletes a contact and records the deletion in its history. The caller triggers
+// contactDeleted once with the ids of all deleted contacts.
+func deleteContact(c *fiber.Ctx, state demoState, id int) error {
+	contact, err := state.contacts.Get(id)
+	if err != nil {
+		return err
+	}
+	if err := state.contacts.Delete(id); err != nil {
+		return err
+	}
+	_, err = state.history.Record(types.ContactChange{
+		Old:     contact,
+		New:     types.Contact{ID: id},
+		Deleted: true,
+		Time:    time.Now(),
+		Session: sessionID(c),
+	})
+	return err
+}
+
+// renderSavedContact records the change in the contact's history, and renders the contact
+// together with a toast offering to undo the change.
+func renderSavedContact(c *fiber.Ctx, state demoState, old, contact types.Contact) error {
+	change, err := recordContactChange(c, state, old, contact)
+	if err != nil {
+		return err
+	}
+	if err := htmx.Trigger(c, "contactChanged", []int{contact.ID}); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderETag, contactETag(contact))
+	if wantsFullPage(c) {
+		return renderFragment(c, templts.ContactForm(contact, false, types.ContactErrors{}))
+	}
+	w := templts.ContactSaved(contact, change)
+	return renderFragment(c, w)
+}
+
+func contactHistoryHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+	w := templts.ContactHistory(state.history.List(id), contact)
+	return renderFragment(c, w)
+}
+
+// contactUndoHandler restores the values from before a change, as long as the contact has not
+// been changed again since.
+func contactUndoHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	changeID, err := c.ParamsInt("change")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	change, err := state.history.Get(changeID)
+	if err != nil || change.New.ID != id {
+		return fiber.NewError(fiber.StatusNotFound, "change not found")
+	}
+
+	restored := change.Old
+	restored.Version = change.New.Version
+	// The old values may no longer be valid, e.g. another contact may have taken the email since.
+	if errs := validateContact(state.contacts, restored); errs.Any() {
+		return fiber.NewError(fiber.StatusConflict, "the change can no longer be undone: "+errs.String())
+	}
+	contact, err := state.contacts.Update(restored)
+	if errors.Is(err, store.ErrConflict) {
+		return fiber.NewError(fiber.StatusConflict, "the contact has been changed since, so the change can no longer be undone")
+	}
+	if err != nil {
+		return contactError(err)
+	}
+	return renderSavedContact(c, state, change.New, contact)
+}
+
+// contactValidateHandler validates a single field as the user leaves it and returns the field
+// with its error message, if any.
+func contactValidateHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := parseContact(c)
+	if err != nil {
+		return err
+	}
+	contact.ID = id
+	errs := validateContact(state.contacts, contact)
+
+	var w templ.Component
+	switch c.Params("field") {
+	case "name":
+		w = templts.ContactField(contact, "name", "Name", contact.Name, true, errs.Name)
+	case "email":
+		w = templts.ContactField(contact, "email", "Email Address", contact.Email, true, errs.Email)
+	default:
+		return fiber.NewError(fiber.StatusNotFound, "unknown field")
+	}
+	return renderFragment(c, w)
+}
+
+func contactsListHandler(c *fiber.Ctx) error {
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	w := templts.ContactsTable(state.contacts.List())
+	return renderFragment(c, w)
+}
+
+// selectedContactIDs returns the ids of the contacts checked in the contacts table. They are
+// read from both the body and the query string, where some clients put the parameters of a
+// DELETE request.
+func selectedContactIDs(c *fiber.Ctx) ([]int, error) {
+	values := append(c.Request().PostArgs().PeekMulti("ids"), c.Request().URI().QueryArgs().PeekMulti("ids")...)
+	if len(values) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "no contacts selected")
+	}
+	var ids []int
+	for _, v := range values {
+		id, err := strconv.Atoi(string(v))
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "invalid contact id")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// contactsBulkUpdateHandler activates or deactivates the selected contacts, and returns only the
+// contacts whose status changed, each swapped out of band over its row.
+func contactsBulkUpdateHandler(c *fiber.Ctx) error {
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	ids, err := selectedContactIDs(c)
+	if err != nil {
+		return err
+	}
+	var active bool
+	switch c.FormValue("status") {
+	case "active":
+		active = true
+	case "inactive":
+		active = false
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "status must be active or inactive")
+	}
+
+	var changed []types.Contact
+	for _, id := range ids {
+		old, err := state.contacts.Get(id)
+		if errors.Is(err, store.ErrNotFound) {
+			// Deleted since the table was rendered, e.g. in another tab.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if old.Active == active {
+			continue
+		}
+		update := old
+		update.Active = active
+		contact, err := state.contacts.Update(update)
+		if err != nil {
+			return contactError(err)
+		}
+		if _, err := recordContactChange(c, state, old, contact); err != nil {
+			return err
+		}
+		changed = append(changed, contact)
+	}
+	if len(changed) > 0 {
+		ids := make([]int, len(changed))
+		for i, contact := range changed {
+			ids[i] = contact.ID
+		}
+		if err := htmx.Trigger(c, "contactChanged", ids); err != nil {
+			return err
+		}
+	}
+
+	w := templts.ContactRowsChanged(changed)
+	return renderRows(c, w)
+}
+
+// contactsBulkDeleteHandler deletes the selected contacts and removes their rows out of band.
+func contactsBulkDeleteHandler(c *fiber.Ctx) error {
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	ids, err := selectedContactIDs(c)
+	if err != nil {
+		return err
+	}
+	var deleted []int
+	for _, id := range ids {
+		err := deleteContact(c, state, id)
+		if errors.Is(err, store.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		deleted = append(deleted, id)
+	}
+	if len(deleted) > 0 {
+		if err := htmx.Trigger(c, "contactDeleted", deleted); err != nil {
+			return err
+		}
+	}
+
+	w := templts.ContactRowsDeleted(deleted)
+	return renderRows(c, w)
+}
+
+func contactRowGetHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+	w := templts.ContactRow(contact, false, false)
+	return renderRows(c, w)
+}
+
+// contactRowEditGetHandler turns a row of the contacts table into inputs. The "editing" query
+// parameter is the id of the row currently in edit mode, if any, which is cancelled by swapping
+// its read-only row out of band, so that only one row is edited at a time.
+func contactRowEditGetHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	contact, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+
+	var cancelled []types.Contact
+	if editing := c.QueryInt("editing"); editing != 0 && editing != id {
+		// The previously edited contact may have been deleted since, then there is nothing to cancel.
+		if previous, err := state.contacts.Get(editing); err == nil {
+			cancelled = append(cancelled, previous)
+		}
+	}
+	w := templts.ContactEditRow(contact, types.ContactErrors{}, cancelled)
+	return renderRows(c, w)
+}
+
+// contactRowPutHandler saves a row edited in place and returns it read-only.
+func contactRowPutHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	update, err := parseContact(c)
+	if err != nil {
+		return err
+	}
+	update.ID = id
+
+	old, err := state.contacts.Get(id)
+	if err != nil {
+		return contactError(err)
+	}
+	update.Active = old.Active
+	if errs := validateContact(state.contacts, update); errs.Any() {
+		w := templts.ContactEditRow(update, errs, nil)
+		return renderRows(c, w, render.WithStatus(fiber.StatusUnprocessableEntity))
+	}
 
-	var update types.Contact
-	if err := c.BodyParser(&update); err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	contact, err := state.contacts.Update(update)
+	if errors.Is(err, store.ErrConflict) {
+		return fiber.NewError(fiber.StatusConflict, "the contact has been changed since it was opened, cancel to see the current values")
+	}
+	if err != nil {
+		return contactError(err)
+	}
+	if _, err := recordContactChange(c, state, old, contact); err != nil {
+		return err
 	}
+	if err := htmx.Trigger(c, "contactChanged", []int{contact.ID}); err != nil {
+		return err
+	}
+	w := templts.ContactRow(contact, false, false)
+	return renderRows(c, w)
+}
 
-	contact.Email = update.Email
-	contact.Name = update.Name
-	w := templts.ContactForm(contact, false)
-	return w.Render(c.Context(), c.Response().BodyWriter())
+// contactDeleteHandler deletes a contact. It responds with 200 and an empty body rather than
+// 204, since htmx does not swap on 204 and the empty body is what replaces the row.
+func contactDeleteHandler(c *fiber.Ctx) error {
+	id, err := contactID(c)
+	if err != nil {
+		return err
+	}
+	state, err := demo(c)
+	if err != nil {
+		return err
+	}
+	if err := deleteContact(c, state, id); err != nil {
+		return contactError(err)
+	}
+	if err := htmx.Trigger(c, "contactDeleted", []int{id}); err != nil {
+		return err
+	}
+	return c.SendString("")
 }
@@ -0,0 +1,75 @@
+// Package htmx provides helpers for reading htmx request headers and writing htmx response
+// headers in Fiber handlers.
+package htmx
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Request holds the htmx request headers. See https://htmx.org/reference/#request_headers.
+type Request struct {
+	// Request is true for every request made by htmx.
+	Request bool
+	// Boosted is true if the request was made by an element using hx-boost.
+	Boosted bool
+	// Target is the id of the target element, if it has one.
+	Target string
+	// Trigger is the id of the triggering element, if it has one.
+	Trigger string
+	// TriggerName is the name of the triggering element, if it has one.
+	TriggerName string
+	// CurrentURL is the current URL of the browser.
+	CurrentURL string
+	// Prompt is the user's response to an hx-prompt.
+	Prompt string
+	// HistoryRestoreRequest is true if the request restores history after a cache miss.
+	HistoryRestoreRequest bool
+}
+
+const localsKey = "htmx.request"
+
+func parseRequest(c *fiber.Ctx) Request {
+	return Request{
+		Request:               c.Get("HX-Request") == "true",
+		Boosted:               c.Get("HX-Boosted") == "true",
+		Target:                c.Get("HX-Target"),
+		Trigger:               c.Get("HX-Trigger"),
+		TriggerName:           c.Get("HX-Trigger-Name"),
+		CurrentURL:            c.Get("HX-Current-URL"),
+		Prompt:                c.Get("HX-Prompt"),
+		HistoryRestoreRequest: c.Get("HX-History-Restore-Request") == "true",
+	}
+}
+
+// New returns a middleware that parses the htmx request headers and stores them in the
+// request's Locals, where they are read by GetRequest.
+func New() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals(localsKey, parseRequest(c))
+		return c.Next()
+	}
+}
+
+// GetRequest returns the htmx request headers. The headers are parsed on demand if the
+// middleware is not installed.
+func GetRequest(c *fiber.Ctx) Request {
+	if r, ok := c.Locals(localsKey).(Request); ok {
+		return r
+	}
+	return parseRequest(c)
+}
+
+// IsHTMX reports whether the request was made by htmx.
+func IsHTMX(c *fiber.Ctx) bool {
+	return GetRequest(c).Request
+}
+
+// IsBoosted reports whether the request was made by an element using hx-boost.
+func IsBoosted(c *fiber.Ctx) bool {
+	return GetRequest(c).Boosted
+}
+
+// IsHistoryRestore reports whether the request restores history after a cache miss.
+func IsHistoryRestore(c *fiber.Ctx) bool {
+	return GetRequest(c).HistoryRestoreRequest
+}
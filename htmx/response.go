@@ -0,0 +1,125 @@
+package htmx
+
+import (
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Swap is an hx-swap value. Modifiers can be appended, e.g. OuterHTML + " swap:1s".
+type Swap string
+
+const (
+	InnerHTML   Swap = "innerHTML"
+	OuterHTML   Swap = "outerHTML"
+	BeforeBegin Swap = "beforebegin"
+	AfterBegin  Swap = "afterbegin"
+	BeforeEnd   Swap = "beforeend"
+	AfterEnd    Swap = "afterend"
+	Delete      Swap = "delete"
+	None        Swap = "none"
+)
+
+// LocationOptions is the context of an HX-Location client side redirect.
+// See https://htmx.org/headers/hx-location/.
+type LocationOptions struct {
+	Path    string            `json:"path"`
+	Source  string            `json:"source,omitempty"`
+	Event   string            `json:"event,omitempty"`
+	Handler string            `json:"handler,omitempty"`
+	Target  string            `json:"target,omitempty"`
+	Swap    Swap              `json:"swap,omitempty"`
+	Select  string            `json:"select,omitempty"`
+	Values  map[string]any    `json:"values,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Location does a client side redirect to path without a full page reload.
+func Location(c *fiber.Ctx, path string) {
+	c.Set("HX-Location", path)
+}
+
+// LocationWith does a client side redirect with the given options.
+func LocationWith(c *fiber.Ctx, opts LocationOptions) error {
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+	c.Set("HX-Location", string(b))
+	return nil
+}
+
+// PushURL pushes url into the browser history.
+func PushURL(c *fiber.Ctx, url string) {
+	c.Set("HX-Push-Url", url)
+}
+
+// PreventPushURL prevents the browser history from being updated.
+func PreventPushURL(c *fiber.Ctx) {
+	c.Set("HX-Push-Url", "false")
+}
+
+// ReplaceURL replaces the current URL in the location bar.
+func ReplaceURL(c *fiber.Ctx, url string) {
+	c.Set("HX-Replace-Url", url)
+}
+
+// Redirect does a client side redirect to url with a full page reload.
+func Redirect(c *fiber.Ctx, url string) {
+	c.Set("HX-Redirect", url)
+}
+
+// Refresh makes the client do a full refresh of the page.
+func Refresh(c *fiber.Ctx) {
+	c.Set("HX-Refresh", "true")
+}
+
+// Reswap overrides how the response is swapped.
+func Reswap(c *fiber.Ctx, swap Swap) {
+	c.Set("HX-Reswap", string(swap))
+}
+
+// Retarget swaps the response into the element matching selector instead of the target.
+func Retarget(c *fiber.Ctx, selector string) {
+	c.Set("HX-Retarget", selector)
+}
+
+// Reselect selects the part of the response matching selector to swap in.
+func Reselect(c *fiber.Ctx, selector string) {
+	c.Set("HX-Reselect", selector)
+}
+
+// Trigger triggers event on the client as soon as the response is received. detail is passed
+// as the event detail and may be nil. Calling it several times triggers all the events, but an
+// event triggered again only keeps the last detail, so pass all of them at once instead.
+func Trigger(c *fiber.Ctx, event string, detail any) error {
+	return addTrigger(c, "HX-Trigger", event, detail)
+}
+
+// TriggerAfterSwap triggers event on the client after the swap step.
+func TriggerAfterSwap(c *fiber.Ctx, event string, detail any) error {
+	return addTrigger(c, "HX-Trigger-After-Swap", event, detail)
+}
+
+// TriggerAfterSettle triggers event on the client after the settle step.
+func TriggerAfterSettle(c *fiber.Ctx, event string, detail any) error {
+	return addTrigger(c, "HX-Trigger-After-Settle", event, detail)
+}
+
+// addTrigger adds event to the JSON object in header, keeping events added earlier.
+func addTrigger(c *fiber.Ctx, header, event string, detail any) error {
+	events := map[string]any{}
+	if existing := c.GetRespHeader(header); existing != "" {
+		if err := json.Unmarshal([]byte(existing), &events); err != nil {
+			return err
+		}
+	}
+	events[event] = detail
+
+	b, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	c.Set(header, string(b))
+	return nil
+}
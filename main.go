@@ -4,37 +4,92 @@ import (
 	_ "embed"
 	"github.com/gofiber/fiber/v2"
 	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+	"github.com/magnuswahlstrand/htmx-experiments/htmx"
+	"github.com/magnuswahlstrand/htmx-experiments/render"
+	"github.com/magnuswahlstrand/htmx-experiments/sse"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 var isDev = os.Getenv("ENV") == "dev"
 
 func main() {
-	app := fiber.New()
+	backend, err := newStateBackend()
+	if err != nil {
+		log.Fatal(err)
+	}
+	demoSessions = newSessions(backend)
+	go demoSessions.expireIdle(time.Minute, sessionIdleTimeout)
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler: errorHandler,
+		// Parsed values are kept in the contact store after the request, so they must not point
+		// into fasthttp's reused request buffers.
+		Immutable: true,
+	})
+	app.Use(htmx.New())
 	app.Get("/", func(c *fiber.Ctx) error {
-		w := templts.Page(serverVersion)
-		c.Set("Content-Type", "text/html")
-		return w.Render(c.Context(), c.Response().BodyWriter())
+		w := templts.Page(serverVersion, exampleCards())
+		return render.Render(c, w, render.WithStreaming())
 	})
 	app.Static("/", "./static")
-	app.Get("/get", getHandler)
 	app.Get("/reload", reloadHandler)
-	app.Get("/color", colorHandler)
+	app.Get("/version", versionHandler)
 	app.Get("/sse", sseHandler)
-	app.Get("/track", trackHandler)
-	app.Post("/slow", slowHandler)
-	contacts := app.Group("/contacts")
-	contacts.Put("/1", contactsUpdatePutHandler)
-	contacts.Get("/1", contactGetHandler)
-	contacts.Get("/1/edit", contactEditGetHandler)
-	app.Get("/click_to_load", clickToLoadHandler)
-	app.Get("/modal", modalHandler)
+	app.Get("/snippets/:slug", snippetHandler)
+	app.Post("/session/reset", sessionResetHandler)
+	if err := loadSnippets(); err != nil {
+		log.Fatalf("highlighting snippets: %v", err)
+	}
+	mountExamples(app)
+
+	if isDev {
+		// Only the files served as they are on disk are watched. Go and templ changes need a rebuild,
+		// and the page reloads when the rebuilt server comes back up with a new version.
+		if err := watchFiles(hub, "static"); err != nil {
+			log.Fatalf("watching files: %v", err)
+		}
+	}
 
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 		log.Printf("defaulting to port %s", port)
 	}
-	log.Fatal(app.Listen(":" + port))
+
+	shutdownTimeout := 10 * time.Second
+	if v := os.Getenv("SHUTDOWN_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid SHUTDOWN_TIMEOUT: %v", err)
+		}
+		shutdownTimeout = d
+	}
+
+	// Listen returns as soon as the listener is closed, so wait for the shutdown to finish
+	// draining requests before exiting.
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGTERM, os.Interrupt)
+		<-sig
+		log.Printf("shutting down, waiting up to %s for in-flight requests", shutdownTimeout)
+
+		// Ask SSE clients to reconnect, which routes them to another instance, before waiting
+		// for the rest of the in-flight requests.
+		hub.Close(sse.Event{Name: "Shutdown", Data: "server is shutting down", Retry: time.Second})
+		if err := app.ShutdownWithTimeout(shutdownTimeout); err != nil {
+			log.Printf("shutdown: %v", err)
+		}
+	}()
+
+	if err := app.Listen(":" + port); err != nil {
+		log.Fatal(err)
+	}
+	<-stopped
+	log.Printf("server stopped")
 }
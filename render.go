@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/a-h/templ"
+	"github.com/gofiber/fiber/v2"
+	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+	"github.com/magnuswahlstrand/htmx-experiments/htmx"
+	"github.com/magnuswahlstrand/htmx-experiments/render"
+)
+
+// wantsFullPage reports whether the response should be a complete document rather than a
+// fragment: the request was not made by htmx, or htmx is restoring history after a cache miss.
+func wantsFullPage(c *fiber.Ctx) bool {
+	return !htmx.IsHTMX(c) || htmx.IsHistoryRestore(c)
+}
+
+// renderFragment renders component as a fragment for htmx and wrapped in the page layout when
+// the URL is opened directly, so that every fragment URL is also a page of its own.
+func renderFragment(c *fiber.Ctx, component templ.Component, opts ...render.Option) error {
+	c.Vary("HX-Request")
+	if wantsFullPage(c) {
+		component = templts.FragmentPage(serverVersion, component)
+	}
+	return render.Render(c, component, opts...)
+}
+
+// renderRows is like renderFragment for fragments made of table rows, which need to be
+// wrapped in a table to be valid on their own.
+func renderRows(c *fiber.Ctx, component templ.Component, opts ...render.Option) error {
+	c.Vary("HX-Request")
+	if wantsFullPage(c) {
+		component = templts.RowsPage(serverVersion, component)
+	}
+	return render.Render(c, component, opts...)
+}
@@ -0,0 +1,84 @@
+// Package render renders templ components as Fiber responses.
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"log"
+
+	"github.com/a-h/templ"
+	"github.com/gofiber/fiber/v2"
+	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+	"github.com/valyala/fasthttp"
+)
+
+type options struct {
+	status       int
+	cacheControl string
+	stream       bool
+}
+
+type Option func(*options)
+
+// WithStatus sets the status code. By default the status already set on the response is kept.
+func WithStatus(status int) Option {
+	return func(o *options) {
+		o.status = status
+	}
+}
+
+// WithCacheControl sets the Cache-Control header, which defaults to "no-cache".
+func WithCacheControl(cacheControl string) Option {
+	return func(o *options) {
+		o.cacheControl = cacheControl
+	}
+}
+
+// WithStreaming writes the component to the client while it renders instead of buffering it.
+// Use it for large components; an error during rendering can then only be logged, since the
+// status and the start of the body have already been sent.
+func WithStreaming() Option {
+	return func(o *options) {
+		o.stream = true
+	}
+}
+
+// Render renders component as an HTML response. The output is buffered, so that an error
+// during rendering results in a 500 error fragment rather than truncated HTML.
+func Render(c *fiber.Ctx, component templ.Component, opts ...Option) error {
+	o := options{
+		status:       c.Response().StatusCode(),
+		cacheControl: "no-cache",
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	c.Set(fiber.HeaderCacheControl, o.cacheControl)
+	c.Status(o.status)
+
+	if o.stream {
+		ctx := c.Context()
+		ctx.SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
+			if err := component.Render(ctx, w); err != nil {
+				log.Printf("streaming %s: %v", ctx.Path(), err)
+			}
+		}))
+		return nil
+	}
+
+	buf := templ.GetBuffer()
+	defer templ.ReleaseBuffer(buf)
+	if err := component.Render(c.Context(), buf); err != nil {
+		log.Printf("rendering %s: %v", c.Path(), err)
+		c.Status(fiber.StatusInternalServerError)
+		buf.Reset()
+		if err := templts.RenderError().Render(c.Context(), buf); err != nil {
+			return err
+		}
+	}
+	// Send keeps a reference to the bytes, so they must be copied before the buffer is released
+	// back to the pool.
+	return c.Send(bytes.Clone(buf.Bytes()))
+}
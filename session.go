@@ -0,0 +1,149 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/magnuswahlstrand/htmx-experiments/htmx"
+)
+
+const (
+	sessionCookie = "session"
+	// sessionIdleTimeout is how long a visitor's demo state is kept after their last request.
+	sessionIdleTimeout = time.Hour
+)
+
+// validSessionID reports whether id looks like an id made by sessionID. Ids are used in file
+// paths, so anything else must be rejected.
+func validSessionID(id string) bool {
+	b, err := hex.DecodeString(id)
+	return err == nil && len(b) == 16
+}
+
+// sessionID returns the visitor's session id, starting a new session if there is none.
+func sessionID(c *fiber.Ctx) string {
+	if id, ok := c.Locals(sessionCookie).(string); ok {
+		return id
+	}
+	if id := c.Cookies(sessionCookie); validSessionID(id) {
+		return id
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	id := hex.EncodeToString(b)
+	c.Locals(sessionCookie, id)
+	c.Cookie(&fiber.Cookie{
+		Name:     sessionCookie,
+		Value:    id,
+		Path:     "/",
+		Expires:  time.Now().Add(30 * 24 * time.Hour),
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+	return id
+}
+
+type session struct {
+	state    demoState
+	lastSeen time.Time
+}
+
+// sessions holds the demo state of the active sessions.
+type sessions struct {
+	backend stateBackend
+
+	mu     sync.Mutex
+	active map[string]*session
+}
+
+// demoSessions is set up in main.
+var demoSessions *sessions
+
+func newSessions(backend stateBackend) *sessions {
+	return &sessions{
+		backend: backend,
+		active:  map[string]*session{},
+	}
+}
+
+func (s *sessions) get(id string) (demoState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.active[id]
+	if !ok {
+		state, err := s.backend.open(id)
+		if err != nil {
+			return demoState{}, err
+		}
+		sess = &session{state: state}
+		s.active[id] = sess
+	}
+	sess.lastSeen = time.Now()
+	return sess.state, nil
+}
+
+// reset throws away the session's state, so that the next request starts from the seed data.
+func (s *sessions) reset(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.active, id)
+	return s.backend.remove(id)
+}
+
+// expire removes the state of the sessions that have been idle for longer than the timeout.
+// Only the bookkeeping is done under the lock, so that removing files does not block requests.
+// A visitor coming back while their files are being removed may find part of their expired
+// state, which is no worse than finding all or none of it.
+func (s *sessions) expire(timeout time.Duration) error {
+	before := time.Now().Add(-timeout)
+	active := map[string]bool{}
+	var expired []string
+
+	s.mu.Lock()
+	for id, sess := range s.active {
+		if sess.lastSeen.After(before) {
+			active[id] = true
+			continue
+		}
+		delete(s.active, id)
+		expired = append(expired, id)
+	}
+	s.mu.Unlock()
+
+	for _, id := range expired {
+		if err := s.backend.remove(id); err != nil {
+			return err
+		}
+	}
+	return s.backend.prune(before, active)
+}
+
+// expireIdle expires idle sessions every interval, forever.
+func (s *sessions) expireIdle(interval, timeout time.Duration) {
+	for range time.Tick(interval) {
+		if err := s.expire(timeout); err != nil {
+			log.Printf("expiring sessions: %v", err)
+		}
+	}
+}
+
+// demo returns the visitor's own copy of the stateful examples' data.
+func demo(c *fiber.Ctx) (demoState, error) {
+	return demoSessions.get(sessionID(c))
+}
+
+func sessionResetHandler(c *fiber.Ctx) error {
+	if err := demoSessions.reset(sessionID(c)); err != nil {
+		return err
+	}
+	htmx.Refresh(c)
+	return c.SendString("")
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/gofiber/fiber/v2"
+	templts "github.com/magnuswahlstrand/htmx-experiments/components"
+)
+
+//go:embed handlers.go components/*.templ
+var sources embed.FS
+
+var snippetFormatter = html.New(html.TabWidth(4))
+
+// templSource returns the source of the templ component called name.
+func templSource(name string) (string, error) {
+	files, err := sources.ReadDir("components")
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		b, err := sources.ReadFile("components/" + f.Name())
+		if err != nil {
+			return "", err
+		}
+		src := string(b)
+		start := strings.Index(src, "templ "+name+"(")
+		if start < 0 {
+			continue
+		}
+		end := strings.Index(src[start:], "\n}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated templ component %q", name)
+		}
+		return src[start : start+end+2], nil
+	}
+	return "", fmt.Errorf("templ component %q not found", name)
+}
+
+// goSource returns the source of the function called name in handlers.go, including its doc comment.
+func goSource(name string) (string, error) {
+	b, err := sources.ReadFile("handlers.go")
+	if err != nil {
+		return "", err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "handlers.go", b, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != name {
+			continue
+		}
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		return string(b[fset.Position(start).Offset:fset.Position(fn.End()).Offset]), nil
+	}
+	return "", fmt.Errorf("function %q not found", name)
+}
+
+func highlightSource(lexer, source string) (string, error) {
+	iterator, err := lexers.Get(lexer).Tokenise(nil, source)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := snippetFormatter.Format(&buf, styles.Get("github"), iterator); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// exampleSnippets holds the highlighted snippets of every example by slug. The sources are
+// embedded, so they are highlighted once at startup rather than on every request, where chroma's
+// regexp timeouts can mark parts of the source as errors when the server is under load.
+var exampleSnippets = map[string][]templts.Snippet{}
+
+func loadSnippets() error {
+	for _, e := range examples {
+		result, err := highlightSnippets(e)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Slug, err)
+		}
+		exampleSnippets[e.Slug] = result
+	}
+	return nil
+}
+
+func highlightSnippets(e example) ([]templts.Snippet, error) {
+	var result []templts.Snippet
+	for _, name := range e.Sources {
+		source, err := templSource(name)
+		if err != nil {
+			return nil, err
+		}
+		highlighted, err := highlightSource("html", source)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, templts.Snippet{Label: name + " (templ)", HTML: highlighted})
+	}
+	for _, name := range e.handlerNames() {
+		source, err := goSource(name)
+		if err != nil {
+			return nil, err
+		}
+		highlighted, err := highlightSource("go", source)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, templts.Snippet{Label: name + " (Go)", HTML: highlighted})
+	}
+	return result, nil
+}
+
+func snippetHandler(c *fiber.Ctx) error {
+	e, ok := exampleBySlug(c.Params("slug"))
+	if !ok {
+		return fiber.NewError(fiber.StatusNotFound, "unknown example")
+	}
+	w := templts.Snippets(exampleSnippets[e.Slug])
+	return renderFragment(c, w)
+}
@@ -0,0 +1,224 @@
+// Package sse implements a small publish/subscribe hub for server-sent events.
+package sse
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event is a single server-sent event. Name becomes the "event:" field and may be empty.
+// ID is assigned by Hub.Publish; events with a zero ID are written without an "id:" field.
+// A non-zero Retry tells the client how long to wait before reconnecting.
+type Event struct {
+	ID    uint64
+	Name  string
+	Data  string
+	Retry time.Duration
+}
+
+// WriteTo writes the event in the text/event-stream format.
+func (e Event) WriteTo(w *bufio.Writer) error {
+	if e.Retry != 0 {
+		if _, err := fmt.Fprintf(w, "retry: %d\n", e.Retry.Milliseconds()); err != nil {
+			return err
+		}
+	}
+	if e.ID != 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", e.ID); err != nil {
+			return err
+		}
+	}
+	if e.Name != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", e.Name); err != nil {
+			return err
+		}
+	}
+	for _, line := range strings.Split(e.Data, "\n") {
+		if _, err := fmt.Fprintf(w, "data: %s\n", line); err != nil {
+			return err
+		}
+	}
+	_, err := w.WriteString("\n")
+	return err
+}
+
+// Subscriber receives the events published to the topics it subscribed to.
+type Subscriber struct {
+	Events chan Event
+	topics []string
+	// replay holds the missed events and the initial events to write before any live events.
+	replay []Event
+	once   sync.Once
+}
+
+// Hub fans out published events to every subscriber of a topic.
+type Hub struct {
+	mu      sync.Mutex
+	topics  map[string]map[*Subscriber]struct{}
+	history map[string][]Event
+	lastID  uint64
+	closed  bool
+
+	// Heartbeat is how often idle streams are written to, to detect closed connections.
+	Heartbeat time.Duration
+	// BufferSize is the number of events that may be queued for a subscriber before it is dropped.
+	BufferSize int
+	// ReplaySize is the number of recent events kept per topic for clients that reconnect.
+	ReplaySize int
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		topics:     map[string]map[*Subscriber]struct{}{},
+		history:    map[string][]Event{},
+		Heartbeat:  15 * time.Second,
+		BufferSize: 16,
+		ReplaySize: 64,
+	}
+}
+
+// Subscribe subscribes to topics. A non-zero lastEventID, as sent by a reconnecting client in
+// the Last-Event-ID header, replays the events published after it that are still kept in the
+// topics' replay buffers. The initial events are written to the subscriber after any replayed
+// ones, unless the hub is already closed.
+func (h *Hub) Subscribe(lastEventID uint64, initial []Event, topics ...string) *Subscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := &Subscriber{
+		Events: make(chan Event, h.BufferSize),
+		topics: topics,
+	}
+	if h.closed {
+		h.unsubscribe(s)
+		return s
+	}
+	for _, topic := range topics {
+		if h.topics[topic] == nil {
+			h.topics[topic] = map[*Subscriber]struct{}{}
+		}
+		h.topics[topic][s] = struct{}{}
+
+		if lastEventID == 0 {
+			continue
+		}
+		for _, e := range h.history[topic] {
+			if e.ID > lastEventID {
+				s.replay = append(s.replay, e)
+			}
+		}
+	}
+	slices.SortFunc(s.replay, func(a, b Event) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	s.replay = append(s.replay, initial...)
+	return s
+}
+
+func (h *Hub) Unsubscribe(s *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.unsubscribe(s)
+}
+
+func (h *Hub) unsubscribe(s *Subscriber) {
+	for _, topic := range s.topics {
+		delete(h.topics[topic], s)
+		if len(h.topics[topic]) == 0 {
+			delete(h.topics, topic)
+		}
+	}
+	s.once.Do(func() { close(s.Events) })
+}
+
+// Publish assigns the event the next ID and sends it to every subscriber of topic. Subscribers
+// that have fallen too far behind to accept the event are dropped; their stream ends and the
+// client reconnects, replaying what it missed.
+func (h *Hub) Publish(topic string, e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID++
+	e.ID = h.lastID
+	history := append(h.history[topic], e)
+	if len(history) > h.ReplaySize {
+		history = history[len(history)-h.ReplaySize:]
+	}
+	h.history[topic] = history
+
+	for s := range h.topics[topic] {
+		select {
+		case s.Events <- e:
+		default:
+			h.unsubscribe(s)
+		}
+	}
+}
+
+// Close sends final to every subscriber and ends their streams. Subscribing to a closed hub
+// returns a subscriber whose stream ends immediately.
+func (h *Hub) Close(final Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, subscribers := range h.topics {
+		for s := range subscribers {
+			select {
+			case s.Events <- final:
+			default:
+			}
+			h.unsubscribe(s)
+		}
+	}
+}
+
+// Subscribers returns the number of subscribers of topic.
+func (h *Hub) Subscribers(topic string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.topics[topic])
+}
+
+// Stream writes the subscriber's events to w until the subscriber is dropped or a write fails.
+// A comment line is written every Heartbeat so that closed connections are noticed even when
+// no events are published. The subscriber is always unsubscribed when Stream returns.
+func (h *Hub) Stream(w *bufio.Writer, s *Subscriber) error {
+	defer h.Unsubscribe(s)
+
+	for _, e := range s.replay {
+		if err := e.WriteTo(w); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(h.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case e, ok := <-s.Events:
+			if !ok {
+				return nil
+			}
+			if err := e.WriteTo(w); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if _, err := w.WriteString(": heartbeat\n\n"); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/magnuswahlstrand/htmx-experiments/types"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// AgentSource is a read-only dataset of agents that can be paged through with cursors.
+type AgentSource struct {
+	agents []types.Agent
+}
+
+func NewAgentSource(agents []types.Agent) *AgentSource {
+	agents = slices.Clone(agents)
+	slices.SortFunc(agents, func(a, b types.Agent) int {
+		return a.ID - b.ID
+	})
+	return &AgentSource{agents: agents}
+}
+
+func (s *AgentSource) All() []types.Agent {
+	return s.agents
+}
+
+// Cursors are opaque to clients. They encode the ID of the last agent of the previous page,
+// so pages stay stable even if agents before the cursor were added or removed.
+const cursorPrefix = "after:"
+
+func encodeCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(id)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), cursorPrefix) {
+		return 0, ErrInvalidCursor
+	}
+	id, err := strconv.Atoi(strings.TrimPrefix(string(b), cursorPrefix))
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	return id, nil
+}
+
+// Page returns up to limit agents after cursor, where an empty cursor starts from the
+// beginning. next is the cursor of the following page, or empty if this is the last page.
+func (s *AgentSource) Page(cursor string, limit int) (page []types.Agent, next string, err error) {
+	start := 0
+	if cursor != "" {
+		after, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		start, _ = slices.BinarySearchFunc(s.agents, after+1, func(a types.Agent, id int) int {
+			return a.ID - id
+		})
+	}
+
+	end := min(start+limit, len(s.agents))
+	page = s.agents[start:end]
+	if end < len(s.agents) {
+		next = encodeCursor(page[len(page)-1].ID)
+	}
+	return page, next, nil
+}
+
+// Query returns the page of agents matching the filters of q, in the order of q, along with the
+// total number of matching agents.
+func (s *AgentSource) Query(q types.AgentQuery) (page []types.Agent, total int) {
+	var matches []types.Agent
+	for _, agent := range s.agents {
+		if containsFold(strconv.Itoa(agent.ID), q.ID) && containsFold(agent.Name, q.Name) {
+			matches = append(matches, agent)
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b types.Agent) int {
+		c := a.ID - b.ID
+		if q.Sort == "name" {
+			c = strings.Compare(a.Name, b.Name)
+		}
+		if q.Desc {
+			c = -c
+		}
+		return c
+	})
+
+	start := min(max(q.Page-1, 0)*q.Size, len(matches))
+	end := min(start+q.Size, len(matches))
+	return matches[start:end], len(matches)
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
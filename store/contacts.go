@@ -0,0 +1,106 @@
+package store
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/magnuswahlstrand/htmx-experiments/types"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when an update is based on an outdated version of a contact.
+	ErrConflict = errors.New("version conflict")
+)
+
+type ContactStore interface {
+	List() []types.Contact
+	Get(id int) (types.Contact, error)
+	Create(contact types.Contact) (types.Contact, error)
+	// Update replaces the contact if contact.Version is the current version, and returns it with
+	// the version incremented. Otherwise it returns the current contact and ErrConflict.
+	Update(contact types.Contact) (types.Contact, error)
+	Delete(id int) error
+}
+
+// MemoryContactStore keeps contacts in process memory. It is safe for concurrent use.
+type MemoryContactStore struct {
+	mu       sync.Mutex
+	nextID   int
+	contacts map[int]types.Contact
+}
+
+func NewMemoryContactStore(contacts ...types.Contact) *MemoryContactStore {
+	s := &MemoryContactStore{
+		nextID:   1,
+		contacts: map[int]types.Contact{},
+	}
+	for _, contact := range contacts {
+		s.Create(contact)
+	}
+	return s
+}
+
+func (s *MemoryContactStore) List() []types.Contact {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contacts := make([]types.Contact, 0, len(s.contacts))
+	for _, contact := range s.contacts {
+		contacts = append(contacts, contact)
+	}
+	slices.SortFunc(contacts, func(a, b types.Contact) int {
+		return a.ID - b.ID
+	})
+	return contacts
+}
+
+func (s *MemoryContactStore) Get(id int) (types.Contact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.contacts[id]
+	if !ok {
+		return types.Contact{}, ErrNotFound
+	}
+	return contact, nil
+}
+
+func (s *MemoryContactStore) Create(contact types.Contact) (types.Contact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact.ID = s.nextID
+	contact.Version = 1
+	s.nextID++
+	s.contacts[contact.ID] = contact
+	return contact, nil
+}
+
+func (s *MemoryContactStore) Update(contact types.Contact) (types.Contact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.contacts[contact.ID]
+	if !ok {
+		return types.Contact{}, ErrNotFound
+	}
+	if contact.Version != current.Version {
+		return current, ErrConflict
+	}
+	contact.Version++
+	s.contacts[contact.ID] = contact
+	return contact, nil
+}
+
+func (s *MemoryContactStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.contacts[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.contacts, id)
+	return nil
+}
@@ -0,0 +1,161 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/magnuswahlstrand/htmx-experiments/types"
+)
+
+// readJSON decodes the file at path into v. It reports false if the file does not exist.
+func readJSON(path string, v any) (bool, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(b, v)
+}
+
+// writeJSON atomically replaces the file at path with v encoded as JSON, by writing to a
+// temporary file in the same directory and renaming it over the old file.
+func writeJSON(path string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+type contactsSnapshot struct {
+	NextID   int
+	Contacts []types.Contact
+}
+
+// FileContactStore is a MemoryContactStore that writes all contacts to a JSON file after every
+// change, and loads them from it on start.
+type FileContactStore struct {
+	*MemoryContactStore
+	path string
+	// mu orders changes with their writes, so an older snapshot never overwrites a newer one.
+	mu sync.Mutex
+}
+
+// OpenFileContactStore opens the store at path. If the file does not exist yet, the store is
+// created with the seed contacts.
+func OpenFileContactStore(path string, seed ...types.Contact) (*FileContactStore, error) {
+	var snapshot contactsSnapshot
+	ok, err := readJSON(path, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileContactStore{path: path}
+	if !ok {
+		s.MemoryContactStore = NewMemoryContactStore(seed...)
+		return s, s.save()
+	}
+	s.MemoryContactStore = NewMemoryContactStore()
+	s.MemoryContactStore.nextID = snapshot.NextID
+	for _, contact := range snapshot.Contacts {
+		s.MemoryContactStore.contacts[contact.ID] = contact
+	}
+	return s, nil
+}
+
+func (s *FileContactStore) save() error {
+	s.MemoryContactStore.mu.Lock()
+	snapshot := contactsSnapshot{NextID: s.MemoryContactStore.nextID}
+	s.MemoryContactStore.mu.Unlock()
+	snapshot.Contacts = s.MemoryContactStore.List()
+	return writeJSON(s.path, snapshot)
+}
+
+func (s *FileContactStore) Create(contact types.Contact) (types.Contact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, err := s.MemoryContactStore.Create(contact)
+	if err != nil {
+		return contact, err
+	}
+	return contact, s.save()
+}
+
+func (s *FileContactStore) Update(contact types.Contact) (types.Contact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, err := s.MemoryContactStore.Update(contact)
+	if err != nil {
+		return contact, err
+	}
+	return contact, s.save()
+}
+
+func (s *FileContactStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.MemoryContactStore.Delete(id); err != nil {
+		return err
+	}
+	return s.save()
+}
+
+// FileContactHistory is a MemoryContactHistory that writes the log to a JSON file after every
+// recorded change, and loads it from it on start.
+type FileContactHistory struct {
+	*MemoryContactHistory
+	path string
+	mu   sync.Mutex
+}
+
+func OpenFileContactHistory(path string) (*FileContactHistory, error) {
+	h := &FileContactHistory{MemoryContactHistory: NewMemoryContactHistory(), path: path}
+	if _, err := readJSON(path, &h.MemoryContactHistory.changes); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *FileContactHistory) Record(change types.ContactChange) (types.ContactChange, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	change, err := h.MemoryContactHistory.Record(change)
+	if err != nil {
+		return change, err
+	}
+	h.MemoryContactHistory.mu.Lock()
+	changes := slices.Clone(h.MemoryContactHistory.changes)
+	h.MemoryContactHistory.mu.Unlock()
+	return change, writeJSON(h.path, changes)
+}
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"sync"
+
+	"github.com/magnuswahlstrand/htmx-experiments/types"
+)
+
+// ContactHistory is an audit log of contact updates.
+type ContactHistory interface {
+	// Record stores the change and returns it with its ID set.
+	Record(change types.ContactChange) (types.ContactChange, error)
+	// List returns the changes of a contact, newest first.
+	List(contactID int) []types.ContactChange
+	Get(id int) (types.ContactChange, error)
+}
+
+// MemoryContactHistory keeps the audit log in process memory. It is safe for concurrent use.
+type MemoryContactHistory struct {
+	mu      sync.Mutex
+	changes []types.ContactChange
+}
+
+func NewMemoryContactHistory() *MemoryContactHistory {
+	return &MemoryContactHistory{}
+}
+
+func (h *MemoryContactHistory) Record(change types.ContactChange) (types.ContactChange, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	change.ID = len(h.changes) + 1
+	h.changes = append(h.changes, change)
+	return change, nil
+}
+
+func (h *MemoryContactHistory) List(contactID int) []types.ContactChange {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var changes []types.ContactChange
+	for i := len(h.changes) - 1; i >= 0; i-- {
+		if h.changes[i].New.ID == contactID {
+			changes = append(changes, h.changes[i])
+		}
+	}
+	return changes
+}
+
+func (h *MemoryContactHistory) Get(id int) (types.ContactChange, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if id < 1 || id > len(h.changes) {
+		return types.ContactChange{}, ErrNotFound
+	}
+	return h.changes[id-1], nil
+}
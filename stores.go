@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/magnuswahlstrand/htmx-experiments/store"
+)
+
+// demoState is the data of the stateful examples. Every visitor gets their own copy.
+type demoState struct {
+	contacts store.ContactStore
+	history  store.ContactHistory
+}
+
+// stateBackend opens and removes the demo state of a session.
+type stateBackend interface {
+	open(sessionID string) (demoState, error)
+	remove(sessionID string) error
+	// prune removes the state of the sessions not in active that have been idle since before.
+	prune(before time.Time, active map[string]bool) error
+}
+
+// newStateBackend returns the backend selected by STORE: "memory" (the default) loses all
+// data on restart, while "file" keeps it in JSON files in DATA_DIR, which defaults to ./data.
+func newStateBackend() (stateBackend, error) {
+	switch kind := os.Getenv("STORE"); kind {
+	case "", "memory":
+		return memoryBackend{}, nil
+	case "file":
+		dir := os.Getenv("DATA_DIR")
+		if dir == "" {
+			dir = "data"
+		}
+		return fileBackend{dir: filepath.Join(dir, "sessions")}, nil
+	default:
+		return nil, fmt.Errorf("unknown STORE %q, expected \"memory\" or \"file\"", kind)
+	}
+}
+
+type memoryBackend struct{}
+
+func (memoryBackend) open(string) (demoState, error) {
+	return demoState{
+		contacts: store.NewMemoryContactStore(seedContacts...),
+		history:  store.NewMemoryContactHistory(),
+	}, nil
+}
+
+func (memoryBackend) remove(string) error {
+	return nil
+}
+
+func (memoryBackend) prune(time.Time, map[string]bool) error {
+	return nil
+}
+
+// fileBackend keeps the state of each session in its own directory.
+type fileBackend struct {
+	dir string
+}
+
+func (b fileBackend) open(sessionID string) (demoState, error) {
+	dir := filepath.Join(b.dir, sessionID)
+	contacts, err := store.OpenFileContactStore(filepath.Join(dir, "contacts.json"), seedContacts...)
+	if err != nil {
+		return demoState{}, err
+	}
+	history, err := store.OpenFileContactHistory(filepath.Join(dir, "contact_history.json"))
+	if err != nil {
+		return demoState{}, err
+	}
+	return demoState{contacts: contacts, history: history}, nil
+}
+
+func (b fileBackend) remove(sessionID string) error {
+	return os.RemoveAll(filepath.Join(b.dir, sessionID))
+}
+
+// prune uses the modification time of the session directories, which is updated whenever a
+// file in them is replaced, since sessions from before a restart are not tracked in memory.
+func (b fileBackend) prune(before time.Time, active map[string]bool) error {
+	entries, err := os.ReadDir(b.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || active[entry.Name()] {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(before) {
+			if err := b.remove(entry.Name()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package types
+
+type Agent struct {
+	ID   int
+	Name string
+}
+
+// AgentQuery describes a view of the agents table: the filters, the sort order and the page.
+type AgentQuery struct {
+	// Sort is the column to sort by, "id" or "name".
+	Sort string
+	Desc bool
+	// ID and Name filter the rows to those whose column contains the value, ignoring case.
+	ID   string
+	Name string
+	// Page is the 1-based page number and Size the number of rows per page.
+	Page int
+	Size int
+}
@@ -1,6 +1,48 @@
 package types
 
+import (
+	"strings"
+	"time"
+)
+
 type Contact struct {
+	ID    int
+	Name  string
+	Email string
+	// Active is false for contacts that have been deactivated.
+	Active bool
+	// Version is incremented on every update, and an update must be based on the current version.
+	Version int
+}
+
+// ContactErrors holds the validation error of each Contact field, empty if the field is valid.
+type ContactErrors struct {
 	Name  string
 	Email string
 }
+
+func (e ContactErrors) Any() bool {
+	return e.Name != "" || e.Email != ""
+}
+
+// String joins the errors of the invalid fields.
+func (e ContactErrors) String() string {
+	var msgs []string
+	for _, msg := range []string{e.Name, e.Email} {
+		if msg != "" {
+			msgs = append(msgs, msg)
+		}
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// ContactChange is an audit entry recording an update of a contact.
+type ContactChange struct {
+	ID  int
+	Old Contact
+	New Contact
+	// Deleted is true if the contact was deleted, in which case New only holds its ID.
+	Deleted bool
+	Time    time.Time
+	Session string
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// version can be injected at build time with -ldflags "-X main.version=<version>". When it is
+// empty, the VCS revision recorded by the Go toolchain is used instead.
+var version string
+
+type buildInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision,omitempty"`
+	Time      string `json:"time,omitempty"`
+	Modified  bool   `json:"modified,omitempty"`
+	GoVersion string `json:"goVersion,omitempty"`
+}
+
+func readBuildInfo() buildInfo {
+	info := buildInfo{Version: version}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.GoVersion = bi.GoVersion
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				info.Revision = s.Value
+			case "vcs.time":
+				info.Time = s.Value
+			case "vcs.modified":
+				info.Modified = s.Value == "true"
+			}
+		}
+	}
+	if info.Version == "" {
+		info.Version = info.Revision
+		if info.Modified {
+			info.Version += "-dirty"
+		}
+	}
+	if info.Version == "" {
+		info.Version = "unknown"
+	}
+	// A dirty working tree keeps the same revision between rebuilds, so in development every
+	// restart counts as a new version.
+	if isDev {
+		info.Version += "+" + strconv.FormatInt(time.Now().Unix(), 10)
+	}
+	return info
+}
+
+var build = readBuildInfo()
+
+// serverVersion identifies the deployed build. All instances running the same build agree on
+// it, so clients only reload when a new build is rolled out.
+var serverVersion = build.Version
+
+func versionHandler(c *fiber.Ctx) error {
+	return c.JSON(build)
+}